@@ -1,19 +1,28 @@
 package facets
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/samber/lo"
 )
 
 var (
-	ProjectType = newFacetProp("project_type")
-	Versions    = newFacetProp("versions")
-	Categories  = newFacetProp("categories")
-	ClientSide  = newFacetProp("client_side")
-	ServerSide  = newFacetProp("server_side")
-	OpenSource  = newFacetProp("open_source")
+	ProjectType      = newFacetProp("project_type")
+	Versions         = newFacetProp("versions")
+	Categories       = newFacetProp("categories")
+	ClientSide       = newFacetProp("client_side")
+	ServerSide       = newFacetProp("server_side")
+	OpenSource       = newFacetProp("open_source")
+	License          = newFacetProp("license")
+	Author           = newFacetProp("author")
+	Downloads        = newFacetProp("downloads")
+	Follows          = newFacetProp("follows")
+	Color            = newFacetProp("color")
+	CreatedTimestamp = newFacetProp("created_timestamp")
 )
 
 func New(props ...FacetProp) FacetsBuilder {
@@ -26,7 +35,8 @@ func New(props ...FacetProp) FacetsBuilder {
 
 type FacetsBuilder interface {
 	And(props ...FacetProp) FacetsBuilder
-	// Or(props ...FacetProp) FacetsBuilder
+	Or(props ...FacetProp) FacetsBuilder
+	Validate() error
 	String() string
 }
 
@@ -51,48 +61,63 @@ func newFacetProp(key string) func() FacetProp {
 // Implements of FacetsProp
 type facetProp struct {
 	key   string
-	state []string // [ `"categories=fabric"`, `"categories=quilt"` ]
+	state []facetCond // [ {":", "fabric"}, {":", "quilt"} ]
 }
 
-// Examlpe: `"categories=fabric","categories=quilt"`
+type facetCond struct {
+	op    string
+	value string
+}
+
+const (
+	opEqual          = ":"
+	opNotEqual       = "!="
+	opLess           = "<"
+	opLessOrEqual    = "<="
+	opGreater        = ">"
+	opGreaterOrEqual = ">="
+)
+
+// Examlpe: `"categories:fabric","categories:quilt"`
 func (f *facetProp) String() string {
-	return strings.Join(f.state, `,`)
+	s := lo.Map(f.state, func(c facetCond, _ int) string {
+		return quote(f.key + c.op + c.value)
+	})
+	return strings.Join(s, `,`)
 }
-func (f *facetProp) Equal(s ...string) FacetProp {
+func (f *facetProp) add(op string, s []string) FacetProp {
 	for _, v := range s {
-		f.state = append(f.state, fmt.Sprintf(`"%s=%s"`, f.key, v))
+		f.state = append(f.state, facetCond{op: op, value: v})
 	}
 	return f
 }
+func (f *facetProp) Equal(s ...string) FacetProp {
+	return f.add(opEqual, s)
+}
 func (f *facetProp) NotEqual(s ...string) FacetProp {
-	for _, v := range s {
-		f.state = append(f.state, fmt.Sprintf(`"%s!=%s"`, f.key, v))
-	}
-	return f
+	return f.add(opNotEqual, s)
 }
 func (f *facetProp) Less(s ...string) FacetProp {
-	for _, v := range s {
-		f.state = append(f.state, fmt.Sprintf(`"%s<%s"`, f.key, v))
-	}
-	return f
+	return f.add(opLess, s)
 }
 func (f *facetProp) LessOrEqual(s ...string) FacetProp {
-	for _, v := range s {
-		f.state = append(f.state, fmt.Sprintf(`"%s<=%s"`, f.key, v))
-	}
-	return f
+	return f.add(opLessOrEqual, s)
 }
 func (f *facetProp) Greater(s ...string) FacetProp {
-	for _, v := range s {
-		f.state = append(f.state, fmt.Sprintf(`"%s>%s"`, f.key, v))
-	}
-	return f
+	return f.add(opGreater, s)
 }
 func (f *facetProp) GreaterOrEqual(s ...string) FacetProp {
-	for _, v := range s {
-		f.state = append(f.state, fmt.Sprintf(`"%s>=%s"`, f.key, v))
-	}
-	return f
+	return f.add(opGreaterOrEqual, s)
+}
+
+// quote encodes s as a JSON string without HTML escaping,
+// so that operators like `>=` stay readable.
+func quote(s string) string {
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
 }
 
 type propList []FacetProp
@@ -111,17 +136,18 @@ type facets struct {
 }
 
 // [ [OR,OR,OR] ]
-// func (f *facets) Or(props ...FacetProp) FacetsBuilder {
-// 	f.props = append(f.props, props)
-// 	return f
-// }
+func (f *facets) Or(props ...FacetProp) FacetsBuilder {
+	if len(props) != 0 {
+		f.props = append(f.props, props)
+	}
+	return f
+}
 
 // [ [AND],[AND],[AND] ]
 func (f *facets) And(props ...FacetProp) FacetsBuilder {
-	f.props = append(f.props, props)
-	// for _, p := range props {
-	// 	f.props = append(f.props, []FacetProp{p})
-	// }
+	for _, p := range props {
+		f.props = append(f.props, propList{p})
+	}
 	return f
 }
 func (f *facets) String() string {
@@ -130,3 +156,22 @@ func (f *facets) String() string {
 	})
 	return fmt.Sprintf("[%s]", strings.Join(s, ","))
 }
+
+// Typed helpers for enum facets.
+// They accept the model constants, e.g. labrinth.ProjectType_Mod, without importing the client package.
+
+func ProjectTypeIs[T ~string](t T) FacetProp {
+	return ProjectType().Equal(string(t))
+}
+
+func ClientSideIs[T ~string](s T) FacetProp {
+	return ClientSide().Equal(string(s))
+}
+
+func ServerSideIs[T ~string](s T) FacetProp {
+	return ServerSide().Equal(string(s))
+}
+
+func OpenSourceIs(b bool) FacetProp {
+	return OpenSource().Equal(strconv.FormatBool(b))
+}
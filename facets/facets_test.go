@@ -0,0 +1,68 @@
+package facets
+
+import "testing"
+
+func TestAndOr(t *testing.T) {
+	tests := []struct {
+		name string
+		b    FacetsBuilder
+		want string
+	}{
+		{
+			name: "search params example",
+			b: New(Categories().Equal("forge"), Versions().Equal("1.17.1"), ProjectType().Equal("mod")).
+				And(License().Equal("mit")),
+			want: `[["categories:forge"],["versions:1.17.1"],["project_type:mod"],["license:mit"]]`,
+		},
+		{
+			name: "or then and",
+			b: New().
+				Or(Categories().Equal("fabric"), Categories().Equal("forge")).
+				And(Versions().Equal("1.20.1")),
+			want: `[["categories:fabric","categories:forge"],["versions:1.20.1"]]`,
+		},
+		{
+			name: "and then or",
+			b: New(ProjectType().Equal("mod")).
+				Or(ClientSide().Equal("required"), ServerSide().Equal("required")),
+			want: `[["project_type:mod"],["client_side:required","server_side:required"]]`,
+		},
+		{
+			name: "empty or is ignored",
+			b:    New(Versions().Equal("1.20.1")).Or(),
+			want: `[["versions:1.20.1"]]`,
+		},
+		{
+			name: "empty",
+			b:    New(),
+			want: `[]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.b.String(); got != tt.want {
+				t.Errorf("String() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPropOperators(t *testing.T) {
+	tests := []struct {
+		prop FacetProp
+		want string
+	}{
+		{Categories().Equal("fabric"), `"categories:fabric"`},
+		{Categories().NotEqual("forge"), `"categories!=forge"`},
+		{Downloads().Less("100"), `"downloads<100"`},
+		{Downloads().LessOrEqual("100"), `"downloads<=100"`},
+		{Follows().Greater("10"), `"follows>10"`},
+		{Follows().GreaterOrEqual("10"), `"follows>=10"`},
+		{Categories().Equal("fabric", "quilt"), `"categories:fabric","categories:quilt"`},
+	}
+	for _, tt := range tests {
+		if got := tt.prop.String(); got != tt.want {
+			t.Errorf("String() = %s, want %s", got, tt.want)
+		}
+	}
+}
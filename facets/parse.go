@@ -0,0 +1,59 @@
+package facets
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Parse reconstructs a FacetsBuilder from its string form,
+// e.g. `[["categories:fabric","categories:quilt"],["versions:1.20.1"]]`.
+// Each inner array becomes an Or clause. Empty clauses are rejected,
+// since they would not survive a round trip through String.
+func Parse(s string) (FacetsBuilder, error) {
+	var clauses [][]string
+	if err := json.Unmarshal([]byte(s), &clauses); err != nil {
+		return nil, fmt.Errorf("facets: malformed expression %q: %w", s, err)
+	}
+
+	f := &facets{}
+	for i, clause := range clauses {
+		if len(clause) == 0 {
+			return nil, fmt.Errorf("facets: empty clause %d in %q", i, s)
+		}
+		props := make(propList, 0, len(clause))
+		for _, expr := range clause {
+			p, err := parseProp(expr)
+			if err != nil {
+				return nil, err
+			}
+			props = append(props, p)
+		}
+		f.Or(props...)
+	}
+	return f, nil
+}
+
+// Longer operators come first so that `<=` is not read as `<`.
+var parseOps = []string{opNotEqual, opLessOrEqual, opGreaterOrEqual, opLess, opGreater, opEqual, "="}
+
+func parseProp(expr string) (*facetProp, error) {
+	i := strings.IndexAny(expr, ":!<>=")
+	if i < 0 {
+		return nil, fmt.Errorf("facets: missing operator in %q", expr)
+	}
+	if i == 0 {
+		return nil, fmt.Errorf("facets: missing key in %q", expr)
+	}
+	for _, op := range parseOps {
+		if strings.HasPrefix(expr[i:], op) {
+			if op == "=" {
+				op = opEqual
+			}
+			p := &facetProp{key: expr[:i]}
+			p.add(op, []string{expr[i+len(op):]})
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("facets: unknown operator in %q", expr)
+}
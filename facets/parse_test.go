@@ -0,0 +1,76 @@
+package facets
+
+import "testing"
+
+func TestParseRoundTrip(t *testing.T) {
+	builders := []FacetsBuilder{
+		New(),
+		New(Categories().Equal("fabric")),
+		New().Or(Categories().Equal("fabric"), Categories().Equal("quilt")).And(Versions().Equal("1.20.1")),
+		New(Categories().NotEqual("forge")),
+		New(Downloads().Less("100")),
+		New(Downloads().LessOrEqual("100")),
+		New(Follows().Greater("10")),
+		New(Follows().GreaterOrEqual("10")),
+		New(Author().Equal(`a "quoted" name`)),
+		New(Author().Equal(`back\slash`)),
+	}
+	for _, b := range builders {
+		want := b.String()
+		got, err := Parse(want)
+		if err != nil {
+			t.Errorf("Parse(%s): %v", want, err)
+			continue
+		}
+		if got.String() != want {
+			t.Errorf("Parse(%s).String() = %s", want, got.String())
+		}
+	}
+}
+
+func TestParseOperators(t *testing.T) {
+	tests := []struct {
+		in   string
+		key  string
+		op   string
+		want string
+	}{
+		{`[["categories:fabric"]]`, "categories", opEqual, "fabric"},
+		{`[["categories=fabric"]]`, "categories", opEqual, "fabric"},
+		{`[["categories!=forge"]]`, "categories", opNotEqual, "forge"},
+		{`[["downloads<100"]]`, "downloads", opLess, "100"},
+		{`[["downloads<=100"]]`, "downloads", opLessOrEqual, "100"},
+		{`[["follows>10"]]`, "follows", opGreater, "10"},
+		{`[["follows>=10"]]`, "follows", opGreaterOrEqual, "10"},
+		{`[["author:a \"b\" c"]]`, "author", opEqual, `a "b" c`},
+	}
+	for _, tt := range tests {
+		b, err := Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(%s): %v", tt.in, err)
+			continue
+		}
+		p := b.(*facets).props[0][0].(*facetProp)
+		if p.key != tt.key || p.state[0].op != tt.op || p.state[0].value != tt.want {
+			t.Errorf("Parse(%s) = %s %s %q, want %s %s %q", tt.in, p.key, p.state[0].op, p.state[0].value, tt.key, tt.op, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, in := range []string{
+		``,
+		`[["categories:fabric"]`,
+		`[["categories:fabric"]]]`,
+		`["categories:fabric"]`,
+		`[[]]`,
+		`[["categories:fabric"],[]]`,
+		`[["categories"]]`,
+		`[[":fabric"]]`,
+		`[["categories!fabric"]]`,
+	} {
+		if _, err := Parse(in); err == nil {
+			t.Errorf("Parse(%s): want error", in)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package facets
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrInvalidFacet = errors.New("facets: invalid facet")
+
+var (
+	knownKeys = []string{
+		"project_type",
+		"versions",
+		"categories",
+		"client_side",
+		"server_side",
+		"open_source",
+		"license",
+		"downloads",
+		"follows",
+		"author",
+		"color",
+		"created_timestamp",
+	}
+	// Keys that accept the comparison operators.
+	numericKeys = []string{
+		"downloads",
+		"follows",
+		"color",
+		"created_timestamp",
+	}
+)
+
+// Validate checks that every prop uses a known key,
+// and that comparison operators are only used on numeric keys.
+func (f *facets) Validate() error {
+	for i, clause := range f.props {
+		for _, prop := range clause {
+			p, ok := prop.(*facetProp)
+			if !ok {
+				continue
+			}
+			if !slices.Contains(knownKeys, p.key) {
+				return fmt.Errorf("%w: clause %d [%s]: unknown key %q", ErrInvalidFacet, i, clause, p.key)
+			}
+			for _, c := range p.state {
+				if c.op != opEqual && c.op != opNotEqual && !slices.Contains(numericKeys, p.key) {
+					return fmt.Errorf("%w: clause %d [%s]: operator %q is only allowed on numeric keys", ErrInvalidFacet, i, clause, c.op)
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package mcversion
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Eras of Minecraft versions, oldest first.
+const (
+	eraUnknown = iota
+	eraPreClassic
+	eraClassic
+	eraInfdev
+	eraAlpha
+	eraBeta
+	eraRelease
+)
+
+// Stages of a release, in the order they are published.
+// stagePost is only used for weekly snapshots newer than releaseWeeks.
+const (
+	stageSnapshot = iota
+	stagePre
+	stageRC
+	stageRelease
+	stagePost
+)
+
+type version struct {
+	era   int
+	nums  []int
+	stage int
+	// Number of the pre-release, release candidate or "-snapshot-N".
+	stageNum int
+	// Week of a weekly snapshot "yyWwwx" as (yy, ww); x is kept in suffix.
+	week   [2]int
+	suffix string
+}
+
+var (
+	modernRe = regexp.MustCompile(`^(\d+(?:\.\d+)*)(?:[- ](.+))?$`)
+	stageRe  = regexp.MustCompile(`^(pre|pre-release|rc|release candidate|snapshot)[- ]?(\d+)$`)
+	weeklyRe = regexp.MustCompile(`^(\d{2})w(\d{2})([a-z].*)$`)
+	oldRe    = regexp.MustCompile(`^(rd|c|inf|a|b)-?(\d+(?:\.\d+)*)(.*)$`)
+)
+
+var oldEras = map[string]int{
+	"rd":  eraPreClassic,
+	"c":   eraClassic,
+	"inf": eraInfdev,
+	"a":   eraAlpha,
+	"b":   eraBeta,
+}
+
+var stages = map[string]int{
+	"pre":               stagePre,
+	"pre-release":       stagePre,
+	"rc":                stageRC,
+	"release candidate": stageRC,
+	"snapshot":          stageSnapshot,
+}
+
+type releaseWeek struct {
+	week    [2]int
+	version []int
+}
+
+// releaseWeeks lists the release week of each version that had weekly snapshots,
+// used to place a snapshot before the release it led up to.
+// Weekly snapshots ended with 1.21.11; later versions use "26.1-snapshot-N".
+var releaseWeeks = []releaseWeek{
+	{[2]int{12, 2}, []int{1, 1}},
+	{[2]int{12, 9}, []int{1, 2, 1}},
+	{[2]int{12, 31}, []int{1, 3, 1}},
+	{[2]int{12, 43}, []int{1, 4, 2}},
+	{[2]int{12, 51}, []int{1, 4, 6}},
+	{[2]int{13, 11}, []int{1, 5}},
+	{[2]int{13, 12}, []int{1, 5, 1}},
+	{[2]int{13, 27}, []int{1, 6, 1}},
+	{[2]int{13, 43}, []int{1, 7, 2}},
+	{[2]int{13, 50}, []int{1, 7, 4}},
+	{[2]int{14, 36}, []int{1, 8}},
+	{[2]int{16, 9}, []int{1, 9}},
+	{[2]int{16, 19}, []int{1, 9, 3}},
+	{[2]int{16, 23}, []int{1, 10}},
+	{[2]int{16, 46}, []int{1, 11}},
+	{[2]int{17, 23}, []int{1, 12}},
+	{[2]int{18, 29}, []int{1, 13}},
+	{[2]int{19, 17}, []int{1, 14}},
+	{[2]int{19, 50}, []int{1, 15}},
+	{[2]int{20, 26}, []int{1, 16}},
+	{[2]int{20, 33}, []int{1, 16, 2}},
+	{[2]int{21, 23}, []int{1, 17}},
+	{[2]int{21, 48}, []int{1, 18}},
+	{[2]int{22, 9}, []int{1, 18, 2}},
+	{[2]int{22, 23}, []int{1, 19}},
+	{[2]int{22, 30}, []int{1, 19, 1}},
+	{[2]int{22, 49}, []int{1, 19, 3}},
+	{[2]int{23, 11}, []int{1, 19, 4}},
+	{[2]int{23, 23}, []int{1, 20}},
+	{[2]int{23, 38}, []int{1, 20, 2}},
+	{[2]int{23, 49}, []int{1, 20, 3}},
+	{[2]int{24, 17}, []int{1, 20, 5}},
+	{[2]int{24, 24}, []int{1, 21}},
+	{[2]int{24, 43}, []int{1, 21, 2}},
+	{[2]int{24, 49}, []int{1, 21, 4}},
+	{[2]int{25, 13}, []int{1, 21, 5}},
+	{[2]int{25, 25}, []int{1, 21, 6}},
+	{[2]int{25, 40}, []int{1, 21, 9}},
+	{[2]int{25, 41}, []int{1, 21, 10}},
+	{[2]int{25, 50}, []int{1, 21, 11}},
+}
+
+func parse(s string) version {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	if m := weeklyRe.FindStringSubmatch(s); m != nil {
+		yy, _ := strconv.Atoi(m[1])
+		ww, _ := strconv.Atoi(m[2])
+		v := version{era: eraRelease, week: [2]int{yy, ww}, suffix: m[3]}
+		i := slices.IndexFunc(releaseWeeks, func(r releaseWeek) bool {
+			return slices.Compare(v.week[:], r.week[:]) < 0
+		})
+		if i < 0 {
+			v.nums, v.stage = releaseWeeks[len(releaseWeeks)-1].version, stagePost
+		} else {
+			v.nums, v.stage = releaseWeeks[i].version, stageSnapshot
+		}
+		return v
+	}
+
+	if m := modernRe.FindStringSubmatch(s); m != nil {
+		v := version{era: eraRelease, nums: parseNums(m[1]), stage: stageRelease}
+		if m[2] == "" {
+			return v
+		}
+		sm := stageRe.FindStringSubmatch(m[2])
+		if sm == nil {
+			return version{suffix: s}
+		}
+		v.stage = stages[sm[1]]
+		v.stageNum, _ = strconv.Atoi(sm[2])
+		return v
+	}
+
+	if m := oldRe.FindStringSubmatch(s); m != nil {
+		return version{era: oldEras[m[1]], nums: parseNums(m[2]), stage: stageRelease, suffix: m[3]}
+	}
+
+	return version{suffix: s}
+}
+
+func parseNums(s string) []int {
+	parts := strings.Split(s, ".")
+	nums := make([]int, len(parts))
+	for i, p := range parts {
+		nums[i], _ = strconv.Atoi(p)
+	}
+	return nums
+}
+
+// compareNums compares version numbers, treating missing parts as 0
+// so that "1.20" equals "1.20.0".
+func compareNums(a, b []int) int {
+	for i := 0; i < max(len(a), len(b)); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			return compareInt(x, y)
+		}
+	}
+	return 0
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Compare returns -1, 0 or +1 depending on whether a is older, the same as or newer than b.
+// It understands releases ("1.20.1", "26.1"), pre-releases ("1.20-pre1", "1.14 Pre-Release 2"),
+// release candidates ("1.20-rc1"), snapshots ("23w45a", "26.1-snapshot-1")
+// and old versions ("b1.7.3", "a1.2.6", "inf-20100618", "c0.30_01c", "rd-132211").
+// Weekly snapshots are placed just before the release they led up to.
+// A weekly snapshot newer than the last release known to this package is placed
+// after that release, so it only compares correctly against versions up to 1.21.11.
+// Unrecognized versions are older than all others and compared as strings.
+func Compare(a, b string) int {
+	va, vb := parse(a), parse(b)
+	if c := compareInt(va.era, vb.era); c != 0 {
+		return c
+	}
+	if va.era == eraUnknown {
+		return strings.Compare(va.suffix, vb.suffix)
+	}
+	if c := compareNums(va.nums, vb.nums); c != 0 {
+		return c
+	}
+	if c := compareInt(va.stage, vb.stage); c != 0 {
+		return c
+	}
+	if c := compareInt(va.stageNum, vb.stageNum); c != 0 {
+		return c
+	}
+	if c := slices.Compare(va.week[:], vb.week[:]); c != 0 {
+		return c
+	}
+	return strings.Compare(va.suffix, vb.suffix)
+}
+
+// Sort sorts versions from oldest to newest.
+func Sort(versions []string) {
+	slices.SortStableFunc(versions, Compare)
+}
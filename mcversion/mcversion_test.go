@@ -0,0 +1,82 @@
+package mcversion
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want int
+	}{
+		{"numeric not lexical", "1.20.1", "1.9.4", 1},
+		{"minor", "1.9", "1.10", -1},
+		{"missing part is zero", "1.20", "1.20.0", 0},
+		{"equal", "1.20.1", "1.20.1", 0},
+		{"new scheme after 1.x", "26.1", "1.21.11", 1},
+		{"pre before release", "1.20-pre1", "1.20", -1},
+		{"pre order", "1.20-pre1", "1.20-pre2", -1},
+		{"long pre-release name", "1.14 Pre-Release 2", "1.14-pre1", 1},
+		{"pre before rc", "1.20-pre7", "1.20-rc1", -1},
+		{"rc before release", "1.20-rc1", "1.20", -1},
+		{"rc after previous release", "1.20-rc1", "1.19.4", 1},
+		{"snapshot before its release", "23w18a", "1.20", -1},
+		{"snapshot after previous release", "23w18a", "1.19.4", 1},
+		{"snapshot before pre-release", "23w18a", "1.20-pre1", -1},
+		{"snapshots by week", "23w17a", "23w18a", -1},
+		{"snapshots by letter", "23w18a", "23w18b", -1},
+		{"snapshot in release week leads up to next", "25w41a", "1.21.10", 1},
+		{"last weekly snapshots", "1.21.10", "25w45a", -1},
+		{"last weekly snapshot before its release", "25w45a", "1.21.11", -1},
+		{"numbered snapshot", "26.1-snapshot-1", "26.1-snapshot-2", -1},
+		{"numbered snapshot before release", "26.1-snapshot-2", "26.1", -1},
+		{"numbered snapshot after weekly", "26.1-snapshot-1", "25w45a", 1},
+		{"beta before release", "b1.7.3", "1.0", -1},
+		{"alpha before beta", "a1.2.6", "b1.0", -1},
+		{"infdev before alpha", "inf-20100618", "a1.0.1", -1},
+		{"classic before infdev", "c0.30_01c", "inf-20100618", -1},
+		{"pre-classic first", "rd-132211", "c0.0.11a", -1},
+		{"unknown before all", "nonsense", "rd-132211", -1},
+		{"unknown as strings", "abc", "abd", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Compare(tt.a, tt.b); got != tt.want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+			}
+			if got := Compare(tt.b, tt.a); got != -tt.want {
+				t.Errorf("Compare(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+			}
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	want := []string{
+		"rd-132211",
+		"c0.30_01c",
+		"inf-20100618",
+		"a1.2.6",
+		"b1.7.3",
+		"1.9.4",
+		"1.19.4",
+		"23w18a",
+		"1.20-pre1",
+		"1.20-rc1",
+		"1.20",
+		"1.20.1",
+		"1.21.10",
+		"25w45a",
+		"1.21.11",
+		"26.1-snapshot-1",
+		"26.1",
+	}
+	got := slices.Clone(want)
+	slices.Reverse(got)
+	Sort(got)
+	if !slices.Equal(got, want) {
+		t.Errorf("Sort = %v, want %v", got, want)
+	}
+}
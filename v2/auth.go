@@ -0,0 +1,59 @@
+package labrinth
+
+import "net/http"
+
+// AuthProvider authorizes outgoing requests.
+type AuthProvider interface {
+	Apply(req *http.Request)
+}
+
+// AuthProviderFunc adapts a function to AuthProvider.
+type AuthProviderFunc func(req *http.Request)
+
+func (f AuthProviderFunc) Apply(req *http.Request) {
+	f(req)
+}
+
+// PATAuth authorizes requests with a personal access token.
+// The token is also valid for GitHub-issued tokens, which the API accepts in the same header.
+type PATAuth string
+
+func (t PATAuth) Apply(req *http.Request) {
+	if t != "" {
+		req.Header.Set("Authorization", string(t))
+	}
+}
+
+func WithAuthProvider(p AuthProvider) Option {
+	return func(c *Client) {
+		c.authMu.Lock()
+		defer c.authMu.Unlock()
+		c.auth = p
+	}
+}
+
+// SetAuthProvider replaces the way requests are authorized.
+// It takes precedence over the token set by SetToken.
+// Like SetToken, it is safe to call while requests are in flight.
+func (c *Client) SetAuthProvider(p AuthProvider) *Client {
+	WithAuthProvider(p)(c)
+	return c
+}
+
+func (c *Client) authProvider() AuthProvider {
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+	if c.auth != nil {
+		return c.auth
+	}
+	if c.AuthToken != "" {
+		return PATAuth(c.AuthToken)
+	}
+	return nil
+}
+
+func (c *Client) applyAuth(req *http.Request) {
+	if auth := c.authProvider(); auth != nil {
+		auth.Apply(req)
+	}
+}
@@ -0,0 +1,54 @@
+package labrinth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Run with -race: SetToken and SetAuthProvider may be called while requests are in flight.
+func TestSetTokenConcurrentWithDo(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); !strings.HasPrefix(auth, "token") && !strings.HasPrefix(auth, "provider") {
+			t.Errorf("Authorization = %q", auth)
+		}
+		w.Write([]byte(`{"id":"abc"}`))
+	}, WithToken("token0"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; ctx.Err() == nil; i++ {
+			c.SetToken(fmt.Sprintf("token%d", i))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; ctx.Err() == nil; i++ {
+			c.SetAuthProvider(PATAuth(fmt.Sprintf("provider%d", i)))
+			c.IsAuthenticated()
+		}
+	}()
+
+	var reqs sync.WaitGroup
+	for g := 0; g < 4; g++ {
+		reqs.Add(1)
+		go func() {
+			defer reqs.Done()
+			for i := 0; i < 10; i++ {
+				if _, _, err := c.Projects.Get(ctx, "abc"); err != nil {
+					t.Errorf("Get: %v", err)
+					return
+				}
+			}
+		}()
+	}
+	reqs.Wait()
+	cancel()
+	wg.Wait()
+}
@@ -0,0 +1,166 @@
+package labrinth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/samber/lo"
+)
+
+const (
+	defaultChunkSize   = 100
+	defaultParallelism = 4
+)
+
+// GetAllConcurrent fetches many projects by splitting ids into chunks of chunkSize,
+// requesting up to parallelism chunks at once with GetAll.
+// The first error cancels the remaining requests and is returned.
+// Non-positive chunkSize and parallelism use defaults.
+func (s *ProjectsService) GetAllConcurrent(ctx context.Context, ids []string, chunkSize, parallelism int) ([]*Project, error) {
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+	if parallelism <= 0 {
+		parallelism = defaultParallelism
+	}
+
+	chunks := lo.Chunk(ids, chunkSize)
+	results := make([][]*Project, len(chunks))
+	err := runBounded(ctx, len(chunks), parallelism, func(ctx context.Context, i int) error {
+		projs, _, err := s.GetAll(ctx, chunks[i])
+		results[i] = projs
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Flatten(results), nil
+}
+
+// FollowAll follows each project, up to defaultParallelism at once.
+// errs holds the error for each of idSlugs, nil on success, and err joins them.
+// Projects not attempted because ctx was cancelled get ctx's error.
+// res is the response of the last finished request.
+func (s *ProjectsService) FollowAll(ctx context.Context, idSlugs []string) ([]error, *Response, error) {
+	return s.eachProject(ctx, idSlugs, s.Follow)
+}
+
+// UnfollowAll is like FollowAll but unfollows each project.
+func (s *ProjectsService) UnfollowAll(ctx context.Context, idSlugs []string) ([]error, *Response, error) {
+	return s.eachProject(ctx, idSlugs, s.Unfollow)
+}
+
+func (s *ProjectsService) eachProject(ctx context.Context, idSlugs []string, fn func(ctx context.Context, idSlug string) (*Response, error)) ([]error, *Response, error) {
+	var (
+		mu   sync.Mutex
+		last *Response
+		errs = make([]error, len(idSlugs))
+		done = make([]bool, len(idSlugs))
+	)
+	// fn errors are collected instead of returned, so one failure does not cancel the rest.
+	ctxErr := runBounded(ctx, len(idSlugs), defaultParallelism, func(ctx context.Context, i int) error {
+		res, err := fn(ctx, idSlugs[i])
+		mu.Lock()
+		defer mu.Unlock()
+		errs[i], done[i] = err, true
+		if res != nil {
+			last = res
+		}
+		return nil
+	})
+	if ctxErr != nil {
+		for i := range errs {
+			if !done[i] {
+				errs[i] = ctxErr
+			}
+		}
+	}
+
+	return errs, last, errors.Join(errs...)
+}
+
+// ReorderGallery sets the ordering of the gallery images of a project
+// to their index in orderedURLs, up to defaultParallelism requests at once.
+// All URLs must be in the current gallery. Failed images are joined in the error.
+func (s *ProjectsService) ReorderGallery(ctx context.Context, idSlug string, orderedURLs []string) error {
+	proj, _, err := s.Get(ctx, idSlug)
+	if err != nil {
+		return err
+	}
+
+	gallery := lo.SliceToMap(proj.Gallery, func(img *GalleryImage) (string, *GalleryImage) {
+		return img.URL, img
+	})
+	unknown := lo.Filter(orderedURLs, func(u string, _ int) bool {
+		return gallery[u] == nil
+	})
+	if len(unknown) != 0 {
+		return fmt.Errorf("not in the gallery of %s: %s", idSlug, strings.Join(unknown, ", "))
+	}
+	if dup := lo.FindDuplicates(orderedURLs); len(dup) != 0 {
+		return fmt.Errorf("duplicated gallery urls: %s", strings.Join(dup, ", "))
+	}
+
+	errs := make([]error, len(orderedURLs))
+	ctxErr := runBounded(ctx, len(orderedURLs), defaultParallelism, func(ctx context.Context, i int) error {
+		u := orderedURLs[i]
+		// Featured is always sent, so it is kept as is.
+		_, err := s.EditGalleryImage(ctx, idSlug, &EditGalleryImageParams{
+			URL:      u,
+			Featured: gallery[u].Featured,
+			Ordering: &i,
+		})
+		if err != nil {
+			errs[i] = fmt.Errorf("%s: %w", u, err)
+		}
+		return nil
+	})
+
+	return errors.Join(append(errs, ctxErr)...)
+}
+
+// runBounded calls fn for 0..n-1 with up to parallelism calls at once.
+// It stops at the first error, cancelling the context given to the other calls.
+func runBounded(ctx context.Context, n, parallelism int, fn func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		sem      = make(chan struct{}, parallelism)
+	)
+
+loop:
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+			break loop
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(ctx, i); err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
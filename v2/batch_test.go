@@ -0,0 +1,53 @@
+package labrinth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestReorderGallery(t *testing.T) {
+	var mu sync.Mutex
+	edits := map[string]string{} // url to query
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"id":"abc","gallery":[{"url":"a","featured":true},{"url":"b"},{"url":"c"}]}`))
+		case http.MethodPatch:
+			q := r.URL.Query()
+			mu.Lock()
+			edits[q.Get("url")] = "featured=" + q.Get("featured") + " ordering=" + q.Get("ordering")
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	if err := c.Projects.ReorderGallery(context.Background(), "abc", []string{"c", "a", "b"}); err != nil {
+		t.Fatalf("ReorderGallery: %v", err)
+	}
+	want := map[string]string{
+		"c": "featured=false ordering=0",
+		"a": "featured=true ordering=1",
+		"b": "featured=false ordering=2",
+	}
+	for u, q := range want {
+		if edits[u] != q {
+			t.Errorf("%s: %q, want %q", u, edits[u], q)
+		}
+	}
+}
+
+func TestReorderGalleryUnknownURL(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.Write([]byte(`{"id":"abc","gallery":[{"url":"a"}]}`))
+	})
+	err := c.Projects.ReorderGallery(context.Background(), "abc", []string{"a", "z"})
+	if err == nil || !strings.Contains(err.Error(), "z") {
+		t.Errorf("err = %v, want one naming z", err)
+	}
+}
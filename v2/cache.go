@@ -0,0 +1,75 @@
+package labrinth
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Cache stores responses for conditional requests.
+// Keys are request URLs and values are opaque bytes,
+// so any key-value store such as Redis can back it.
+//
+// Responses may depend on the token, so a cache should not be shared
+// between clients authorized as different users.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte)
+}
+
+// SetCache enables caching of GET responses validated by ETag or Last-Modified.
+// A nil cache disables caching, which is the default.
+func (c *Client) SetCache(cache Cache) *Client {
+	c.cache = cache
+	return c
+}
+
+type cacheEntry struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+	Body         []byte `json:"body"`
+}
+
+// cachedEntry returns the cached entry for req, and a copy of req
+// with the conditional headers to revalidate it, leaving the caller's request untouched.
+// Without an entry, req is returned as is.
+func (c *Client) cachedEntry(req *http.Request) (*http.Request, *cacheEntry) {
+	if c.cache == nil || req.Method != http.MethodGet {
+		return req, nil
+	}
+	data, ok := c.cache.Get(req.URL.String())
+	if !ok {
+		return req, nil
+	}
+	entry := new(cacheEntry)
+	if err := json.Unmarshal(data, entry); err != nil {
+		return req, nil
+	}
+
+	req = req.Clone(req.Context())
+	if entry.ETag != "" {
+		req.Header.Set("If-None-Match", entry.ETag)
+	}
+	if entry.LastModified != "" {
+		req.Header.Set("If-Modified-Since", entry.LastModified)
+	}
+	return req, entry
+}
+
+func (c *Client) storeCache(req *http.Request, res *http.Response, body []byte) {
+	if c.cache == nil || req.Method != http.MethodGet || res.StatusCode != http.StatusOK {
+		return
+	}
+	entry := &cacheEntry{
+		ETag:         res.Header.Get("ETag"),
+		LastModified: res.Header.Get("Last-Modified"),
+		Body:         body,
+	}
+	if entry.ETag == "" && entry.LastModified == "" {
+		return
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	c.cache.Set(req.URL.String(), data)
+}
@@ -0,0 +1,84 @@
+package cache
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLRUCacheEvictionOrder(t *testing.T) {
+	c := NewLRUCache(2, 0)
+	c.Set("a", []byte("1"))
+	c.Set("b", []byte("2"))
+	// Using a makes b the least recently used.
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("a missing")
+	}
+	c.Set("c", []byte("3"))
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("b not evicted")
+	}
+	for _, k := range []string{"a", "c"} {
+		if _, ok := c.Get(k); !ok {
+			t.Errorf("%s evicted", k)
+		}
+	}
+	if c.Len() != 2 {
+		t.Errorf("Len = %d, want 2", c.Len())
+	}
+}
+
+func TestLRUCacheOverwrite(t *testing.T) {
+	c := NewLRUCache(2, 0)
+	c.Set("a", []byte("1"))
+	c.Set("b", []byte("2"))
+	c.Set("a", []byte("3"))
+	c.Set("c", []byte("4"))
+
+	if v, ok := c.Get("a"); !ok || string(v) != "3" {
+		t.Errorf("a = %s %v, want 3", v, ok)
+	}
+	if _, ok := c.Get("b"); ok {
+		t.Error("b not evicted")
+	}
+}
+
+func TestLRUCacheTTL(t *testing.T) {
+	c := NewLRUCache(0, 20*time.Millisecond)
+	c.Set("a", []byte("1"))
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("a missing before ttl")
+	}
+	time.Sleep(40 * time.Millisecond)
+	if _, ok := c.Get("a"); ok {
+		t.Error("a not expired")
+	}
+	if c.Len() != 0 {
+		t.Errorf("Len = %d, want 0 after expiry", c.Len())
+	}
+}
+
+func TestLRUCacheConcurrent(t *testing.T) {
+	c := NewLRUCache(16, time.Minute)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				k := strconv.Itoa((g + i) % 32)
+				c.Set(k, []byte(k))
+				if v, ok := c.Get(k); ok && string(v) != k {
+					t.Errorf("Get(%s) = %s", k, v)
+				}
+				c.Len()
+			}
+		}(g)
+	}
+	wg.Wait()
+	if c.Len() > 16 {
+		t.Errorf("Len = %d, want at most 16", c.Len())
+	}
+}
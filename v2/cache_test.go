@@ -0,0 +1,62 @@
+package labrinth
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+type mapCache struct {
+	mu sync.Mutex
+	m  map[string][]byte
+}
+
+func (c *mapCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.m[key]
+	return v, ok
+}
+
+func (c *mapCache) Set(key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.m[key] = value
+}
+
+func TestCacheLeavesRequestUntouched(t *testing.T) {
+	cache := &mapCache{m: map[string][]byte{}}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"x"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"x"`)
+		w.Write([]byte(`{"id":"abc"}`))
+	}).SetCache(cache)
+
+	req, _ := c.NewRequest(http.MethodGet, "project/abc", nil)
+	for i := 0; i < 2; i++ {
+		data, res, err := c.DoRaw(context.Background(), req)
+		if err != nil {
+			t.Fatalf("DoRaw %d: %v", i, err)
+		}
+		if string(data) != `{"id":"abc"}` {
+			t.Errorf("DoRaw %d: body = %s", i, data)
+		}
+		if want := []int{http.StatusOK, http.StatusNotModified}[i]; res.StatusCode != want {
+			t.Errorf("DoRaw %d: status = %d, want %d", i, res.StatusCode, want)
+		}
+		if v := req.Header.Get("If-None-Match"); v != "" {
+			t.Errorf("DoRaw %d: caller's request has If-None-Match %s", i, v)
+		}
+	}
+
+	// Once the entry is evicted, replaying the request must fetch the body again.
+	delete(cache.m, req.URL.String())
+	data, _, err := c.DoRaw(context.Background(), req)
+	if err != nil || string(data) != `{"id":"abc"}` {
+		t.Errorf("DoRaw after eviction = %s, %v", data, err)
+	}
+}
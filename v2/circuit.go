@@ -0,0 +1,107 @@
+package labrinth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreaker fails requests fast with ErrCircuitOpen during outages.
+// After threshold consecutive 5xx responses or transport errors it opens for the cooldown,
+// then lets a single probe request through: success closes it, failure opens it again.
+type CircuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time // zero while closed
+	probing  bool
+}
+
+type circuitResult int
+
+const (
+	circuitSuccess circuitResult = iota
+	circuitFailure
+	// Neither, e.g. when the caller cancelled the request.
+	circuitIgnored
+)
+
+// NewCircuitBreaker returns a breaker opening after threshold consecutive failures
+// for cooldown. A non-positive threshold is treated as 1.
+func NewCircuitBreaker(threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{threshold: max(threshold, 1), cooldown: cooldown}
+}
+
+// SetCircuitBreaker makes requests fail fast according to b.
+// A nil breaker disables it, which is the default.
+func (c *Client) SetCircuitBreaker(b *CircuitBreaker) *Client {
+	c.breaker = b
+	return c
+}
+
+// allow reports whether a request may be sent and, if so, whether it is the probe.
+func (b *CircuitBreaker) allow() (probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.openedAt.IsZero() {
+		return false, nil
+	}
+	if b.probing || time.Since(b.openedAt) < b.cooldown {
+		return false, ErrCircuitOpen
+	}
+	b.probing = true
+	return true, nil
+}
+
+// record counts the result of a request allowed by allow.
+// While the breaker is open only the probe's result counts, so that requests
+// sent before it opened cannot close it or end the probe.
+func (b *CircuitBreaker) record(probe bool, r circuitResult) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if probe {
+		b.probing = false
+	} else if !b.openedAt.IsZero() {
+		return
+	}
+
+	switch r {
+	case circuitSuccess:
+		b.failures = 0
+		b.openedAt = time.Time{}
+	case circuitFailure:
+		b.failures++
+		if probe || b.failures >= b.threshold {
+			b.openedAt = time.Now()
+		}
+	}
+}
+
+// send performs req through the circuit breaker, if any.
+func (c *Client) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	b := c.breaker
+	if b == nil {
+		return c.sendWithRetry(ctx, req)
+	}
+	probe, err := b.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.sendWithRetry(ctx, req)
+	switch {
+	case ctx.Err() != nil:
+		b.record(probe, circuitIgnored)
+	case err != nil || res.StatusCode >= 500:
+		b.record(probe, circuitFailure)
+	default:
+		b.record(probe, circuitSuccess)
+	}
+	return res, err
+}
@@ -0,0 +1,127 @@
+package labrinth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreakerOpens(t *testing.T) {
+	status := http.StatusInternalServerError
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}).SetCircuitBreaker(NewCircuitBreaker(2, 20*time.Millisecond))
+	get := func() error {
+		req, err := c.NewRequest(http.MethodGet, "project/a", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = c.Do(context.Background(), req, nil)
+		return err
+	}
+
+	for i := range 2 {
+		if err := get(); errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("request %d: %v before the threshold", i, err)
+		}
+	}
+	if err := get(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("after the threshold: %v, want %v", err, ErrCircuitOpen)
+	}
+
+	// A failing probe opens it again for the cooldown.
+	time.Sleep(30 * time.Millisecond)
+	if err := get(); errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("probe: %v, want it sent", err)
+	}
+	if err := get(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("after a failed probe: %v, want %v", err, ErrCircuitOpen)
+	}
+
+	// A successful probe closes it.
+	status = http.StatusOK
+	time.Sleep(30 * time.Millisecond)
+	for i := range 3 {
+		if err := get(); err != nil {
+			t.Fatalf("request %d after a successful probe: %v", i, err)
+		}
+	}
+}
+
+func TestCircuitBreakerStaleRequestDuringProbe(t *testing.T) {
+	tests := []struct {
+		name        string
+		staleStatus int
+	}{
+		{"stale success", http.StatusOK},
+		{"stale failure", http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received := make(chan string, 4)
+			staleDone := make(chan struct{})
+			probeDone := make(chan struct{})
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				received <- r.URL.Path
+				switch r.URL.Path {
+				case "/v2/stale":
+					<-staleDone
+					w.WriteHeader(tt.staleStatus)
+				case "/v2/probe":
+					<-probeDone
+					w.WriteHeader(http.StatusInternalServerError)
+				default:
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}).SetCircuitBreaker(NewCircuitBreaker(1, 20*time.Millisecond))
+			// Released on failure too, so that closing the server does not wait for the handlers.
+			releaseStale := sync.OnceFunc(func() { close(staleDone) })
+			releaseProbe := sync.OnceFunc(func() { close(probeDone) })
+			t.Cleanup(releaseStale)
+			t.Cleanup(releaseProbe)
+			get := func(path string) <-chan error {
+				done := make(chan error, 1)
+				go func() {
+					req, err := c.NewRequest(http.MethodGet, path, nil)
+					if err != nil {
+						done <- err
+						return
+					}
+					_, err = c.Do(context.Background(), req, nil)
+					done <- err
+				}()
+				return done
+			}
+
+			// Sent while closed, then outlives the outage and the cooldown.
+			stale := get("stale")
+			<-received
+			if err := <-get("fail"); errors.Is(err, ErrCircuitOpen) {
+				t.Fatalf("fail: %v, want it sent", err)
+			}
+			<-received
+
+			time.Sleep(30 * time.Millisecond)
+			probe := get("probe")
+			<-received
+			if err := <-get("other"); !errors.Is(err, ErrCircuitOpen) {
+				t.Fatalf("during the probe: %v, want %v", err, ErrCircuitOpen)
+			}
+
+			releaseStale()
+			<-stale
+			if err := <-get("other"); !errors.Is(err, ErrCircuitOpen) {
+				t.Fatalf("after the stale request finished: %v, want %v while probing", err, ErrCircuitOpen)
+			}
+
+			releaseProbe()
+			<-probe
+			if err := <-get("other"); !errors.Is(err, ErrCircuitOpen) {
+				t.Fatalf("after the probe failed: %v, want %v", err, ErrCircuitOpen)
+			}
+		})
+	}
+}
@@ -3,17 +3,21 @@ package labrinth
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	neturl "net/url"
+	"os"
+	"reflect"
 	"runtime"
+	"runtime/debug"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
-
-	"github.com/samber/lo"
 )
 
 const (
@@ -22,11 +26,16 @@ const (
 	headerRateLimit     = "X-Ratelimit-Limit"
 	headerRateRemaining = "X-Ratelimit-Remaining"
 	headerRateReset     = "X-Ratelimit-Reset"
+	headerRetryAfter    = "Retry-After"
+
+	// Canonical path of the repository, identifying the client in the default User-Agent
+	// whatever the module is named in a local checkout.
+	repoPath = "github.com/ookkoouu/labrinth-client"
 )
 
 var (
-	appVersion       = "dev"
-	defaultUserAgent = "github.com/ookkoouu/labrinth-client/" + appVersion
+	appVersion       = buildVersion()
+	defaultUserAgent = repoPath + "/" + appVersion
 
 	supportedImageExt = []string{
 		"png",
@@ -41,6 +50,28 @@ var (
 	}
 )
 
+// buildVersion returns the version of this module from the build info,
+// or "dev" when it is unknown, such as in a local checkout.
+func buildVersion() string {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "dev"
+	}
+	pkg := reflect.TypeOf(Client{}).PkgPath()
+	for _, m := range append([]*debug.Module{&bi.Main}, bi.Deps...) {
+		if m.Replace != nil {
+			m = m.Replace
+		}
+		if pkg != m.Path && !strings.HasPrefix(pkg, m.Path+"/") {
+			continue
+		}
+		if m.Version != "" && m.Version != "(devel)" {
+			return m.Version
+		}
+	}
+	return "dev"
+}
+
 type JSONMarshaler = func(v interface{}) ([]byte, error)
 type JSONUnmarshaler = func(data []byte, v interface{}) error
 
@@ -48,29 +79,41 @@ type service struct {
 	client *Client
 }
 
-// TODO: implement services
-type NotificationsService service
-type MiscService service
-type TagsService service
-type TeamsService service
-type ThreadsService service
-type UsersService service
-type VersionFilesService service
-type VersionsService service
-
+// Client is safe for concurrent use once configured.
+// Configure it before making requests, except for SetToken and SetAuthProvider,
+// which may be called at any time, e.g. from a goroutine refreshing the token.
 type Client struct {
 	hc        *http.Client
 	BaseURL   *neturl.URL
 	UserAgent string
+	// Set it with SetToken; assigning it directly is not safe while requests are in flight.
 	AuthToken string
+	authMu    sync.RWMutex // guards AuthToken, auth and tokens
+	tokens    *tokenSource
+	headers   http.Header
 	JSONMarshaler
 	JSONUnmarshaler
 
+	retryPolicy *RetryPolicy
+	jitter      jitterRand
+	rateLimiter RateLimiter
+	breaker     *CircuitBreaker
+	logger      Logger
+	tracer      Tracer
+	metrics     Recorder
+	cache       Cache
+	auth        AuthProvider
+	middlewares []Middleware
+	transport   *http.Client // hc wrapped with middlewares
+	categories  categoryCache
+
 	common service
 
 	Notifications *NotificationsService
 	Projects      *ProjectsService
-	Misc    *MiscService
+	Misc          *MiscService
+	Organizations *OrganizationsService
+	Reports       *ReportsService
 	Tags          *TagsService
 	Teams         *TeamsService
 	Threads       *ThreadsService
@@ -79,8 +122,11 @@ type Client struct {
 	Versions      *VersionsService
 }
 
-func NewClient() *Client {
+func NewClient(opts ...Option) *Client {
 	c := &Client{}
+	for _, opt := range opts {
+		opt(c)
+	}
 	c.init()
 	return c
 }
@@ -89,17 +135,29 @@ func (c *Client) init() {
 	if c.hc == nil {
 		c.hc = createClient()
 	}
+	c.buildTransport()
 	if c.BaseURL == nil {
-		c.BaseURL, _ = neturl.ParseRequestURI(APIBaseURL)
+		c.BaseURL, _ = parseBaseURL(APIBaseURL)
 	}
 	if c.UserAgent == "" {
 		c.UserAgent = defaultUserAgent
 	}
+	if c.JSONMarshaler == nil {
+		c.JSONMarshaler = json.Marshal
+	}
+	if c.JSONUnmarshaler == nil {
+		c.JSONUnmarshaler = json.Unmarshal
+	}
+	if c.metrics == nil {
+		c.metrics = nopRecorder{}
+	}
 
 	c.common.client = c
 	c.Notifications = (*NotificationsService)(&c.common)
 	c.Projects = (*ProjectsService)(&c.common)
 	c.Misc = (*MiscService)(&c.common)
+	c.Organizations = (*OrganizationsService)(&c.common)
+	c.Reports = (*ReportsService)(&c.common)
 	c.Tags = (*TagsService)(&c.common)
 	c.Teams = (*TeamsService)(&c.common)
 	c.Threads = (*ThreadsService)(&c.common)
@@ -110,45 +168,52 @@ func (c *Client) init() {
 
 type RequestOption func(req *http.Request)
 
-func (c *Client) NewRequest(method, path string, body any, opts ...RequestOption) (*http.Request, error) {
-	u, err := c.BaseURL.Parse(path)
-	if err != nil {
-		return nil, err
+// resolveURL resolves path against BaseURL.
+// BaseURL is treated as a directory even without a trailing "/",
+// so that "project/x" resolves to ".../v2/project/x" instead of dropping "/v2".
+func (c *Client) resolveURL(path string) (*neturl.URL, error) {
+	base := c.BaseURL
+	if !strings.HasSuffix(base.Path, "/") {
+		b := *base
+		b.Path += "/"
+		if b.RawPath != "" {
+			b.RawPath += "/"
+		}
+		base = &b
 	}
+	return base.Parse(path)
+}
 
-	var rd *bytes.Reader
+func (c *Client) NewRequest(method, path string, body any, opts ...RequestOption) (*http.Request, error) {
+	var rd io.Reader
+	var contentType string
 	if body != nil {
 		data, err := c.JSONMarshaler(body)
 		if err != nil {
 			return nil, err
 		}
 		rd = bytes.NewReader(data)
+		contentType = "application/json"
 	}
 
-	req, err := http.NewRequest(method, u.String(), rd)
-	if err != nil {
-		return nil, err
-	}
-
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
-	}
-	if c.UserAgent != "" {
-		req.Header.Set("User-Agent", c.UserAgent)
-	}
-	if c.AuthToken != "" {
-		req.Header.Set("Authorization", c.AuthToken)
-	}
+	return c.newRequest(method, path, rd, contentType, opts...)
+}
 
-	for _, opt := range opts {
-		opt(req)
-	}
+// NewFormRequest builds a multipart request without a boundary parameter.
+// For a body written by multipart.Writer, use NewUploadRequest with
+// Writer.FormDataContentType so that the server can parse the body.
+func (c *Client) NewFormRequest(method, path string, body io.Reader, opts ...RequestOption) (*http.Request, error) {
+	return c.newRequest(method, path, body, "multipart/form-data", opts...)
+}
 
-	return req, nil
+func (c *Client) NewUploadRequest(method, path, contentType string, body io.Reader, opts ...RequestOption) (*http.Request, error) {
+	return c.newRequest(method, path, body, contentType, opts...)
 }
 
-func (c *Client) NewFormRequest(method, path string, body io.Reader, opts ...RequestOption) (*http.Request, error) {
-	u, err := c.BaseURL.Parse(path)
+// newRequest builds a request with the headers common to all requests.
+// An empty contentType leaves the header unset.
+func (c *Client) newRequest(method, path string, body io.Reader, contentType string, opts ...RequestOption) (*http.Request, error) {
+	u, err := c.resolveURL(path)
 	if err != nil {
 		return nil, err
 	}
@@ -157,13 +222,22 @@ func (c *Client) NewFormRequest(method, path string, body io.Reader, opts ...Req
 	if err != nil {
 		return nil, err
 	}
+	if f, ok := body.(*os.File); ok {
+		if err := setFileBody(req, f); err != nil {
+			return nil, err
+		}
+	}
 
-	req.Header.Set("Content-Type", "multipart/form-data")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
 	if c.UserAgent != "" {
 		req.Header.Set("User-Agent", c.UserAgent)
 	}
-	if c.AuthToken != "" {
-		req.Header.Set("Authorization", c.AuthToken)
+	req.Header.Set("Accept-Encoding", acceptEncoding)
+	c.applyAuth(req)
+	for k, vs := range c.headers {
+		req.Header[k] = append([]string(nil), vs...)
 	}
 
 	for _, opt := range opts {
@@ -173,36 +247,60 @@ func (c *Client) NewFormRequest(method, path string, body io.Reader, opts ...Req
 	return req, nil
 }
 
-func (c *Client) NewUploadRequest(method, path, contentType string, body io.Reader, opts ...RequestOption) (*http.Request, error) {
-	u, err := c.BaseURL.Parse(path)
+// setFileBody makes req send f with a Content-Length instead of chunked encoding.
+// http.NewRequest does this for in-memory readers but not for files.
+// The file stays open, since it is owned by the caller.
+func setFileBody(req *http.Request, f *os.File) error {
+	fi, err := f.Stat()
 	if err != nil {
-		return nil, err
+		return err
 	}
-
-	req, err := http.NewRequest(method, u.String(), body)
+	if !fi.Mode().IsRegular() {
+		return nil
+	}
+	offset, err := f.Seek(0, io.SeekCurrent)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	req.Header.Set("Content-Type", contentType)
-	if c.UserAgent != "" {
-		req.Header.Set("User-Agent", c.UserAgent)
+	req.ContentLength = fi.Size() - offset
+	req.Body = io.NopCloser(f)
+	req.GetBody = func() (io.ReadCloser, error) {
+		if _, err := f.Seek(offset, io.SeekStart); err != nil {
+			return nil, err
+		}
+		return io.NopCloser(f), nil
 	}
-	if c.AuthToken != "" {
-		req.Header.Set("Authorization", c.AuthToken)
+	if req.ContentLength == 0 {
+		req.Body = http.NoBody
+		req.GetBody = func() (io.ReadCloser, error) { return http.NoBody, nil }
 	}
+	return nil
+}
 
-	for _, opt := range opts {
-		opt(req)
-	}
+var (
+	ErrInvalidInput = errors.New("invalid input")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+)
 
-	return req, nil
+var statusErrors = map[int]error{
+	http.StatusBadRequest:   ErrInvalidInput,
+	http.StatusUnauthorized: ErrUnauthorized,
+	http.StatusForbidden:    ErrForbidden,
+	http.StatusNotFound:     ErrNotFound,
 }
 
 type ErrorResponse struct {
 	Response    *http.Response `json:"-"`
 	Code        string         `json:"error"`
 	Description string         `json:"description"`
+	// Field-level validation messages keyed by field name.
+	// Only present on some 400 responses.
+	Errors map[string]string `json:"errors,omitempty"`
+	// Raw response body.
+	Body []byte `json:"-"`
 }
 
 func (r *ErrorResponse) Error() string {
@@ -217,6 +315,28 @@ func (r *ErrorResponse) Error() string {
 	return fmt.Sprintf("%v", r.Description)
 }
 
+// Is maps the HTTP status code to the sentinel errors,
+// so that errors.Is(err, ErrNotFound) works.
+func (r *ErrorResponse) Is(target error) bool {
+	if r.Response == nil {
+		return false
+	}
+	err, ok := statusErrors[r.Response.StatusCode]
+	return ok && err == target
+}
+
+// RateLimitError is returned for 429 Too Many Requests responses.
+type RateLimitError struct {
+	*ErrorResponse
+	Rate Rate
+	// Time to wait before retrying; see Rate.RetryAfter.
+	RetryAfter time.Duration
+}
+
+func (r *RateLimitError) Unwrap() error {
+	return r.ErrorResponse
+}
+
 type Rate struct {
 	// Maximum number of requests that can be made in a minute
 	Limit int
@@ -224,6 +344,14 @@ type Rate struct {
 	Remaining int
 	// Time in seconds until the ratelimit window resets
 	Reset int
+	// Time to wait from the Retry-After header, zero when absent.
+	// Some responses, e.g. from a CDN, send it instead of the X-Ratelimit-* headers.
+	RetryAfter time.Duration
+}
+
+// retryWait returns the larger of Reset and RetryAfter.
+func (r Rate) retryWait() time.Duration {
+	return max(time.Duration(r.Reset)*time.Second, r.RetryAfter)
 }
 
 type Response struct {
@@ -238,17 +366,32 @@ func (c *Client) checkResponse(r *http.Response) error {
 	}
 
 	errResp := &ErrorResponse{Response: r}
-	if 500 <= r.StatusCode {
-		errResp.Description = "internal server error"
-		return errResp
-	}
-
 	data, err := io.ReadAll(r.Body)
-	if err == nil && data != nil {
+	if err == nil && len(data) != 0 {
+		// Keep the body readable for callers inspecting the response.
+		r.Body = io.NopCloser(bytes.NewReader(data))
 		err = c.JSONUnmarshaler(data, errResp)
 		if err != nil {
 			errResp = &ErrorResponse{Response: r}
 		}
+		errResp.Body = data
+	}
+
+	if 500 <= r.StatusCode && errResp.Description == "" {
+		errResp.Description = strings.TrimSpace(string(errResp.Body))
+		if errResp.Description == "" {
+			errResp.Description = "internal server error"
+		}
+		return errResp
+	}
+
+	if r.StatusCode == http.StatusTooManyRequests {
+		rate := parseRate(r)
+		return &RateLimitError{
+			ErrorResponse: errResp,
+			Rate:          rate,
+			RetryAfter:    rate.retryWait(),
+		}
 	}
 
 	return errResp
@@ -265,20 +408,120 @@ func parseRate(r *http.Response) Rate {
 	if reset := r.Header.Get(headerRateReset); reset != "" {
 		rate.Reset, _ = strconv.Atoi(reset)
 	}
+	if after := r.Header.Get(headerRetryAfter); after != "" {
+		rate.RetryAfter = parseRetryAfter(after, time.Now())
+	}
 	return rate
+}
 
+// parseRetryAfter parses a Retry-After value in either seconds or HTTP-date form.
+// RFC 1123 dates in a zone other than GMT are accepted too, as some servers send them.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	v = strings.TrimSpace(v)
+	if secs, err := strconv.Atoi(v); err == nil {
+		return max(time.Duration(secs)*time.Second, 0)
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(t.Sub(now), 0)
+	}
+	for _, layout := range []string{time.RFC1123, time.RFC1123Z} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return max(t.Sub(now), 0)
+		}
+	}
+	return 0
 }
 
 func (c *Client) Do(ctx context.Context, req *http.Request, respData any) (*Response, error) {
-	req = req.WithContext(ctx)
-	res, err := c.hc.Do(req)
+	bodyData, response, err := c.DoRaw(ctx, req)
+	if response != nil {
+		response.Data = respData
+	}
+	if err != nil {
+		return response, err
+	}
+
+	if respData == nil || len(bodyData) == 0 {
+		return response, nil
+	}
+
+	err = c.JSONUnmarshaler(bodyData, response.Data)
+	if err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+// DoRaw is like Do but returns the response body undecoded.
+func (c *Client) DoRaw(ctx context.Context, req *http.Request) ([]byte, *Response, error) {
+	op := c.operationName(req)
+	end := func(error) {}
+	if c.tracer != nil {
+		ctx, end = c.tracer(ctx, op)
+	}
+
+	start := time.Now()
+	data, res, err := c.doRaw(ctx, req)
+	status := 0
+	if res != nil {
+		status = res.StatusCode
+	}
+	c.metrics.ObserveRequest(req.Method, strings.TrimPrefix(op, req.Method+" "), status, time.Since(start))
+	end(err)
+	return data, res, err
+}
+
+// DoStream sends req and returns the response body unread, to stream large payloads.
+// The caller owns the returned reader and must close it.
+// For non-2xx responses the body is read into the returned error and closed.
+func (c *Client) DoStream(ctx context.Context, req *http.Request) (*Response, io.ReadCloser, error) {
+	req = withContext(ctx, req)
+	res, err := c.sendAuthorized(ctx, req)
 	if err != nil {
 		select {
 		case <-ctx.Done():
-			return nil, ctx.Err()
+			return nil, nil, ctx.Err()
 		default:
 		}
-		return nil, err
+		return nil, nil, err
+	}
+
+	response := &Response{
+		Response: res,
+		Rate:     parseRate(res),
+	}
+
+	if err := c.checkResponse(res); err != nil {
+		res.Body.Close()
+		return response, nil, err
+	}
+
+	return response, res.Body, nil
+}
+
+// GetRaw sends a GET request to path and returns the undecoded body.
+// It gives access to fields not modeled yet.
+func (c *Client) GetRaw(ctx context.Context, path string) ([]byte, *Response, error) {
+	req, err := c.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return c.DoRaw(ctx, req)
+}
+
+func (c *Client) doRaw(ctx context.Context, req *http.Request) ([]byte, *Response, error) {
+	req = withContext(ctx, req)
+	req, entry := c.cachedEntry(req)
+	res, err := c.sendAuthorized(ctx, req)
+	if err != nil {
+		select {
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		default:
+		}
+		return nil, nil, err
 	}
 
 	defer res.Body.Close()
@@ -286,55 +529,143 @@ func (c *Client) Do(ctx context.Context, req *http.Request, respData any) (*Resp
 	response := &Response{
 		Response: res,
 		Rate:     parseRate(res),
-		Data:     respData,
+	}
+
+	if entry != nil && res.StatusCode == http.StatusNotModified {
+		return entry.Body, response, nil
 	}
 
 	err = c.checkResponse(res)
 	if err != nil {
-		return response, err
+		return nil, response, err
 	}
 
-	bodyData, err := io.ReadAll(res.Body)
-	if err != nil {
-		return response, err
+	if res.StatusCode == http.StatusNoContent {
+		return nil, response, nil
 	}
 
-	err = c.JSONUnmarshaler(bodyData, response.Data)
+	bodyData, err := io.ReadAll(res.Body)
 	if err != nil {
-		return response, err
+		return nil, response, err
 	}
+	c.storeCache(req, res, bodyData)
 
-	return response, nil
+	return bodyData, response, nil
 }
 
 func (c *Client) SetHTTPClient(hc *http.Client) *Client {
-	c.hc = hc
+	WithHTTPClient(hc)(c)
+	return c
+}
+
+// SetTimeout sets the timeout of the underlying HTTP client, which defaults to 5 minutes.
+// See WithTimeout for how it interacts with context deadlines.
+func (c *Client) SetTimeout(d time.Duration) *Client {
+	WithTimeout(d)(c)
 	return c
 }
 
 func (c *Client) SetToken(token string) *Client {
-	c.AuthToken = token
+	WithToken(token)(c)
 	return c
 }
 
+// IsAuthenticated reports whether a token is set.
+// It does not check the token is valid.
+func (c *Client) IsAuthenticated() bool {
+	return c.authProvider() != nil || c.tokenSource() != nil
+}
+
+// Ping checks that the client can reach the API with valid credentials
+// by requesting the authorized user. An invalid or missing token gives
+// an error matching ErrUnauthorized with errors.Is.
+func (c *Client) Ping(ctx context.Context) error {
+	req, err := c.NewRequest(http.MethodGet, "user", nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.Do(ctx, req, nil)
+	return err
+}
+
 func (c *Client) SetBaseURL(url string) *Client {
-	u, _ := neturl.Parse(url)
-	c.BaseURL = u
+	WithBaseURL(url)(c)
 	return c
 }
 
+// SetBaseURLE is like SetBaseURL but returns an error for an invalid URL.
+func (c *Client) SetBaseURLE(url string) error {
+	u, err := parseBaseURL(url)
+	if err != nil {
+		return err
+	}
+	c.BaseURL = u
+	return nil
+}
+
 func (c *Client) SetUserAgent(ua string) *Client {
-	c.UserAgent = ua
+	WithUserAgent(ua)(c)
+	return c
+}
+
+// SetHeader sets a header sent with every request, such as a CF-Access token.
+// Headers with sensitive names are redacted in the logging hook.
+func (c *Client) SetHeader(key, value string) *Client {
+	WithHeader(key, value)(c)
+	return c
+}
+
+// SetAcceptLanguage sets the Accept-Language header sent with every request, e.g. "ja".
+// An empty tag stops sending it.
+func (c *Client) SetAcceptLanguage(tag string) *Client {
+	if tag == "" {
+		c.headers.Del("Accept-Language")
+		return c
+	}
+	return c.SetHeader("Accept-Language", tag)
+}
+
+// SetUserAgentParts sets the User-Agent in the format recommended by Modrinth,
+// "project/version (contact)". version may be empty; project and contact may not.
+func (c *Client) SetUserAgentParts(project, version, contact string) error {
+	project, version, contact = strings.TrimSpace(project), strings.TrimSpace(version), strings.TrimSpace(contact)
+	if project == "" {
+		return errors.New("user agent project is required")
+	}
+	if contact == "" {
+		return errors.New("user agent contact is required")
+	}
+
+	ua := project
+	if version != "" {
+		ua += "/" + version
+	}
+	c.UserAgent = fmt.Sprintf("%s (%s)", ua, contact)
+	return nil
+}
+
+// SetRetryPolicy enables retrying requests rejected by the rate limit.
+// A nil policy disables retrying.
+func (c *Client) SetRetryPolicy(p *RetryPolicy) *Client {
+	WithRetry(p)(c)
+	return c
+}
+
+// SetRateLimiter makes requests wait for l before being sent.
+// A nil limiter disables limiting.
+func (c *Client) SetRateLimiter(l RateLimiter) *Client {
+	c.rateLimiter = l
 	return c
 }
 
 func (c *Client) SetJSONMarshaler(marshaler JSONMarshaler) *Client {
-	c.JSONMarshaler = marshaler
+	WithJSONMarshaler(marshaler)(c)
 	return c
 }
 
 func (c *Client) SetJSONUnmarshaler(unmarshaler JSONUnmarshaler) *Client {
-	c.JSONUnmarshaler = unmarshaler
+	WithJSONUnmarshaler(unmarshaler)(c)
 	return c
 }
 
@@ -362,9 +693,23 @@ func createClient() *http.Client {
 	}
 }
 
-func queryArray(v []string) string {
-	s := lo.Map(v, func(item string, i int) string {
-		return `"` + item + `"`
-	})
-	return fmt.Sprintf("[%s]", strings.Join(s, ","))
+// queryArray encodes v as a JSON array for query parameters like `ids=["a","b"]`.
+func (c *Client) queryArray(v []string) (string, error) {
+	if v == nil {
+		v = []string{}
+	}
+	data, err := c.JSONMarshaler(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// deref returns the value p points to, or the zero value if p is nil.
+func deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
 }
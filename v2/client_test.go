@@ -0,0 +1,215 @@
+package labrinth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	neturl "net/url"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// newTestClient starts a server responding with h and returns a client pointed at its /v2 base.
+func newTestClient(t *testing.T, h http.HandlerFunc, opts ...Option) *Client {
+	t.Helper()
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	return NewClient(append([]Option{WithBaseURL(srv.URL + "/v2")}, opts...)...)
+}
+
+func TestErrorResponseIs(t *testing.T) {
+	tests := []struct {
+		status int
+		want   error
+	}{
+		{http.StatusBadRequest, ErrInvalidInput},
+		{http.StatusUnauthorized, ErrUnauthorized},
+		{http.StatusForbidden, ErrForbidden},
+		{http.StatusNotFound, ErrNotFound},
+	}
+	sentinels := []error{ErrInvalidInput, ErrUnauthorized, ErrForbidden, ErrNotFound}
+	for _, tt := range tests {
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+			w.Write([]byte(`{"error":"e","description":"d"}`))
+		})
+		_, _, err := c.Projects.Get(context.Background(), "abc")
+		for _, s := range sentinels {
+			if got := errors.Is(err, s); got != (s == tt.want) {
+				t.Errorf("%d: errors.Is(err, %v) = %v", tt.status, s, got)
+			}
+		}
+	}
+}
+
+func TestRateLimitErrorUnwrap(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateReset, "3")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"error":"ratelimit_error","description":"d"}`))
+	})
+	_, _, err := c.Projects.Get(context.Background(), "abc")
+
+	var rle *RateLimitError
+	if !errors.As(err, &rle) || rle.Rate.Reset != 3 {
+		t.Fatalf("err = %v, want *RateLimitError with Reset 3", err)
+	}
+	var er *ErrorResponse
+	if !errors.As(err, &er) || er.Code != "ratelimit_error" {
+		t.Errorf("errors.As(err, *ErrorResponse) = %v, want code ratelimit_error", er)
+	}
+	for _, s := range []error{ErrInvalidInput, ErrUnauthorized, ErrForbidden, ErrNotFound} {
+		if errors.Is(err, s) {
+			t.Errorf("errors.Is(err, %v) = true", s)
+		}
+	}
+}
+
+func TestNewClientDefaultsJSON(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":"AANobbMI","slug":"sodium"}`))
+	})
+	proj, _, err := c.Projects.Get(context.Background(), "sodium")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if proj.ID != "AANobbMI" || proj.Slug != "sodium" {
+		t.Errorf("got %s %s, want AANobbMI sodium", proj.ID, proj.Slug)
+	}
+}
+
+func TestDoNoContent(t *testing.T) {
+	var method string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		w.WriteHeader(http.StatusNoContent)
+	})
+	res, err := c.Projects.Delete(context.Background(), "abc")
+	if err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if method != http.MethodDelete || res.StatusCode != http.StatusNoContent {
+		t.Errorf("got %s %d, want DELETE 204", method, res.StatusCode)
+	}
+}
+
+func TestDoEmptyBody(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {})
+	req, _ := c.NewRequest(http.MethodPatch, "project/abc", nil)
+	var v map[string]any
+	if _, err := c.Do(context.Background(), req, &v); err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if v != nil {
+		t.Errorf("v = %v, want untouched", v)
+	}
+}
+
+func TestResolveURLKeepsBasePath(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/v2/search":
+			w.Write([]byte(`{"hits":[]}`))
+		case "/v2/projects":
+			w.Write([]byte(`[]`))
+		default:
+			w.Write([]byte(`{}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient()
+	// Assigned directly, so that the path does not get the trailing "/" of parseBaseURL.
+	c.BaseURL, _ = neturl.Parse(srv.URL + "/v2")
+
+	ctx := context.Background()
+	if _, _, err := c.Projects.Get(ctx, "abc"); err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if _, _, err := c.Projects.Search(ctx, nil); err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if _, _, err := c.Projects.GetAll(ctx, []string{"abc"}); err != nil {
+		t.Fatalf("GetAll: %v", err)
+	}
+
+	want := []string{"/v2/project/abc", "/v2/search", "/v2/projects"}
+	if !slices.Equal(paths, want) {
+		t.Errorf("paths = %q, want %q", paths, want)
+	}
+}
+
+func TestQueryArrayAdversarial(t *testing.T) {
+	ids := []string{`a"b`, `c,d`, `e\f`, `["g"]`, `h&ids=i`}
+	var got []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("ids")), &got); err != nil {
+			t.Errorf("ids: %v", err)
+		}
+		w.Write([]byte(`[]`))
+	})
+	if _, _, err := c.Projects.GetAll(context.Background(), ids); err != nil {
+		t.Fatalf("GetAll: %v", err)
+	}
+	if !slices.Equal(got, ids) {
+		t.Errorf("ids = %q, want %q", got, ids)
+	}
+
+	if s, _ := c.queryArray(nil); s != "[]" {
+		t.Errorf("queryArray(nil) = %s, want []", s)
+	}
+}
+
+func TestNewRequestsShareHeaders(t *testing.T) {
+	c := NewClient(WithToken("mrp_token"), WithUserAgent("proj/1.0 (me@example.com)"))
+	build := map[string]func() (*http.Request, error){
+		"NewRequest": func() (*http.Request, error) {
+			return c.NewRequest(http.MethodPost, "project", map[string]string{})
+		},
+		"NewFormRequest": func() (*http.Request, error) {
+			return c.NewFormRequest(http.MethodPost, "project", strings.NewReader(""))
+		},
+		"NewUploadRequest": func() (*http.Request, error) {
+			return c.NewUploadRequest(http.MethodPatch, "project/x/icon?ext=png", "image/png", strings.NewReader(""))
+		},
+	}
+	for name, f := range build {
+		req, err := f()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if got := req.Header.Get("User-Agent"); got != "proj/1.0 (me@example.com)" {
+			t.Errorf("%s: User-Agent = %q", name, got)
+		}
+		if got := req.Header.Get("Authorization"); got != "mrp_token" {
+			t.Errorf("%s: Authorization = %q", name, got)
+		}
+		if got := req.Header.Get("Accept-Encoding"); got != acceptEncoding {
+			t.Errorf("%s: Accept-Encoding = %q", name, got)
+		}
+	}
+}
+
+func TestDefaultUserAgent(t *testing.T) {
+	// The test binary is built from the main module, whose version is unknown.
+	want := "github.com/ookkoouu/labrinth-client/dev"
+	if ua := NewClient().UserAgent; ua != want {
+		t.Errorf("UserAgent = %q, want %q", ua, want)
+	}
+
+	var got string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+	})
+	if _, _, err := c.GetRaw(context.Background(), "tag/loader"); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("sent User-Agent %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,60 @@
+package labrinth
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strings"
+)
+
+const acceptEncoding = "gzip, deflate"
+
+// decoders maps a Content-Encoding to the reader that undoes it.
+var decoders = map[string]func(io.Reader) (io.Reader, error){
+	"gzip": func(r io.Reader) (io.Reader, error) {
+		return gzip.NewReader(r)
+	},
+	"deflate": func(r io.Reader) (io.Reader, error) {
+		return zlib.NewReader(r)
+	},
+}
+
+// decodeBody replaces the body of res with its decoded form,
+// since setting Accept-Encoding disables the transparent decoding of net/http.
+func decodeBody(res *http.Response) {
+	enc := strings.ToLower(strings.TrimSpace(res.Header.Get("Content-Encoding")))
+	newReader, ok := decoders[enc]
+	if !ok {
+		return
+	}
+
+	res.Body = &decodingReader{body: res.Body, newReader: newReader}
+	res.Header.Del("Content-Encoding")
+	res.Header.Del("Content-Length")
+	res.ContentLength = -1
+	res.Uncompressed = true
+}
+
+// decodingReader defers creating the decoder to the first Read,
+// so an empty body reads as empty instead of failing.
+type decodingReader struct {
+	body      io.ReadCloser
+	newReader func(io.Reader) (io.Reader, error)
+	r         io.Reader
+	err       error
+}
+
+func (d *decodingReader) Read(p []byte) (int, error) {
+	if d.r == nil && d.err == nil {
+		d.r, d.err = d.newReader(d.body)
+	}
+	if d.err != nil {
+		return 0, d.err
+	}
+	return d.r.Read(p)
+}
+
+func (d *decodingReader) Close() error {
+	return d.body.Close()
+}
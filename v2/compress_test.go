@@ -0,0 +1,60 @@
+package labrinth
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestDecodeBody(t *testing.T) {
+	const body = `{"id":"abc","slug":"sodium"}`
+	compress := map[string]func(w io.Writer) io.WriteCloser{
+		"gzip":    func(w io.Writer) io.WriteCloser { return gzip.NewWriter(w) },
+		"deflate": func(w io.Writer) io.WriteCloser { return zlib.NewWriter(w) },
+	}
+	for enc, newWriter := range compress {
+		t.Run(enc, func(t *testing.T) {
+			var accept string
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				accept = r.Header.Get("Accept-Encoding")
+				buf := new(bytes.Buffer)
+				zw := newWriter(buf)
+				zw.Write([]byte(body))
+				zw.Close()
+				w.Header().Set("Content-Encoding", enc)
+				w.Write(buf.Bytes())
+			})
+			proj, res, err := c.Projects.Get(context.Background(), "sodium")
+			if err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+			if accept != acceptEncoding {
+				t.Errorf("Accept-Encoding = %q, want %q", accept, acceptEncoding)
+			}
+			if proj.ID != "abc" || proj.Slug != "sodium" {
+				t.Errorf("got %s %s, want abc sodium", proj.ID, proj.Slug)
+			}
+			if res.Header.Get("Content-Encoding") != "" || !res.Uncompressed {
+				t.Errorf("response still marked as %s encoded", res.Header.Get("Content-Encoding"))
+			}
+		})
+	}
+}
+
+func TestDecodeBodyEmpty(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusNoContent} {
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Encoding", "gzip")
+			w.WriteHeader(status)
+		})
+		req, _ := c.NewRequest(http.MethodDelete, "project/abc", nil)
+		var v map[string]any
+		if _, err := c.Do(context.Background(), req, &v); err != nil {
+			t.Errorf("%d: Do: %v", status, err)
+		}
+	}
+}
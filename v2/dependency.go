@@ -0,0 +1,113 @@
+package labrinth
+
+import (
+	"context"
+	"fmt"
+)
+
+// Maximum depth of the dependency tree walked by ResolveRequiredVersions.
+const maxDependencyDepth = 32
+
+// ResolveRequiredVersions walks the required dependencies of a version transitively,
+// picking the newest version of each dependency project that matches loader and gameVersion,
+// unless the dependency pins a specific version.
+//
+// The versions are de-duplicated by project and ordered so that every version comes after
+// its own dependencies. The version itself is not included.
+func (s *ProjectsService) ResolveRequiredVersions(ctx context.Context, versionID string, gameVersion string, loader string) ([]*Version, *Response, error) {
+	root, res, err := s.client.Versions.Get(ctx, versionID)
+	if err != nil {
+		return nil, res, err
+	}
+
+	r := &dependencyResolver{
+		s:           s,
+		gameVersion: gameVersion,
+		loader:      loader,
+		visited:     map[string]bool{root.ProjectID: true},
+		res:         res,
+	}
+	if err := r.walk(ctx, root, 0); err != nil {
+		return nil, r.res, err
+	}
+
+	return r.resolved, r.res, nil
+}
+
+type dependencyResolver struct {
+	s           *ProjectsService
+	gameVersion string
+	loader      string
+	visited     map[string]bool // by project id
+	resolved    []*Version
+	res         *Response
+}
+
+func (r *dependencyResolver) walk(ctx context.Context, v *Version, depth int) error {
+	if depth >= maxDependencyDepth {
+		return fmt.Errorf("dependency tree of %s is deeper than %d", v.ID, maxDependencyDepth)
+	}
+
+	for _, dep := range v.Dependencies {
+		if dep.DependencyType != DependencyType_Required {
+			continue
+		}
+
+		// Known projects are skipped upfront; this also breaks cycles.
+		if dep.ProjectID != nil && r.visited[*dep.ProjectID] {
+			continue
+		}
+
+		depVersion, err := r.resolve(ctx, dep)
+		if err != nil {
+			return err
+		}
+		if depVersion == nil || r.visited[depVersion.ProjectID] {
+			continue
+		}
+		r.visited[depVersion.ProjectID] = true
+
+		if err := r.walk(ctx, depVersion, depth+1); err != nil {
+			return err
+		}
+		r.resolved = append(r.resolved, depVersion)
+	}
+	return nil
+}
+
+// resolve returns the version pinned by dep, or the best match in its project.
+// It returns nil when the dependency cannot be matched to a version.
+func (r *dependencyResolver) resolve(ctx context.Context, dep *VersionDependency) (*Version, error) {
+	if dep.VersionID != nil {
+		v, res, err := r.s.client.Versions.Get(ctx, *dep.VersionID)
+		r.res = res
+		return v, err
+	}
+	if dep.ProjectID == nil {
+		return nil, nil
+	}
+
+	params := &ListProjectVersionsParams{}
+	if r.loader != "" {
+		params.Loaders = []string{r.loader}
+	}
+	if r.gameVersion != "" {
+		params.GameVersions = []string{r.gameVersion}
+	}
+	versions, res, err := r.s.GetVersions(ctx, *dep.ProjectID, params)
+	r.res = res
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, fmt.Errorf("no version of %s matches the loader and game version", *dep.ProjectID)
+	}
+
+	// Versions are listed newest first; prefer a release over pre-releases.
+	for _, v := range versions {
+		if v.VersionType == VersionType_Release {
+			return v, nil
+		}
+	}
+	return versions[0], nil
+}
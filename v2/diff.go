@@ -0,0 +1,76 @@
+package labrinth
+
+import (
+	"reflect"
+	"slices"
+)
+
+// DiffProject returns a project with only the fields of new that differ from old,
+// so that passing it to Edit sends a minimal PATCH. It returns nil when nothing differs.
+// An optional URL set in old but not in new becomes a pointer to "", which Edit sends as a clear.
+// Changes Edit cannot send, such as emptying Body or Categories or removing the license, are ignored.
+func DiffProject(old, new *Project) *Project {
+	d := &Project{
+		IssuesURL:  diffOptional(old.IssuesURL, new.IssuesURL),
+		SourceURL:  diffOptional(old.SourceURL, new.SourceURL),
+		WikiURL:    diffOptional(old.WikiURL, new.WikiURL),
+		DiscordURL: diffOptional(old.DiscordURL, new.DiscordURL),
+	}
+	if old.Slug != new.Slug {
+		d.Slug = new.Slug
+	}
+	if old.Title != new.Title {
+		d.Title = new.Title
+	}
+	if old.Description != new.Description {
+		d.Description = new.Description
+	}
+	if !slices.Equal(old.Categories, new.Categories) {
+		d.Categories = new.Categories
+	}
+	if old.ClientSide != new.ClientSide {
+		d.ClientSide = new.ClientSide
+	}
+	if old.ServerSide != new.ServerSide {
+		d.ServerSide = new.ServerSide
+	}
+	if old.Body != new.Body {
+		d.Body = new.Body
+	}
+	if deref(old.RequestedStatus) != deref(new.RequestedStatus) {
+		d.RequestedStatus = new.RequestedStatus
+	}
+	if !slices.Equal(old.AdditionalCategories, new.AdditionalCategories) {
+		d.AdditionalCategories = new.AdditionalCategories
+	}
+	if !slices.EqualFunc(old.DonationUrls, new.DonationUrls, func(a, b *ProjectDonationURL) bool {
+		return deref(a) == deref(b)
+	}) {
+		d.DonationUrls = new.DonationUrls
+	}
+	if new.License != nil {
+		oldLicense := deref(old.License)
+		l := &ProjectLicense{URL: diffOptional(oldLicense.URL, new.License.URL)}
+		if oldLicense.ID != new.License.ID {
+			l.ID = new.License.ID
+		}
+		if l.ID != "" || l.URL != nil {
+			d.License = l
+		}
+	}
+
+	if reflect.ValueOf(*d).IsZero() {
+		return nil
+	}
+	return d
+}
+
+// diffOptional returns nil when old and new are equal,
+// a pointer to "" when new clears old, and new otherwise.
+func diffOptional(old, new *string) *string {
+	if deref(old) == deref(new) {
+		return nil
+	}
+	v := deref(new)
+	return &v
+}
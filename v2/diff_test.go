@@ -0,0 +1,93 @@
+package labrinth
+
+import "testing"
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestDiffProject(t *testing.T) {
+	base := func() *Project {
+		return &Project{
+			Slug:         "my-mod",
+			Title:        "My Mod",
+			Categories:   []string{"utility"},
+			ClientSide:   ProjectSideSupport_Required,
+			SourceURL:    ptr("https://git/src"),
+			WikiURL:      ptr("https://wiki"),
+			License:      &ProjectLicense{ID: "MIT", URL: ptr("https://mit")},
+			DonationUrls: []*ProjectDonationURL{{ID: "patreon", URL: "https://patreon"}},
+		}
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		if d := DiffProject(base(), base()); d != nil {
+			t.Errorf("DiffProject = %+v, want nil", d)
+		}
+	})
+
+	t.Run("set", func(t *testing.T) {
+		n := base()
+		n.Title = "New"
+		n.IssuesURL = ptr("https://git/issues")
+		d := DiffProject(base(), n)
+		if d == nil || d.Title != "New" || deref(d.IssuesURL) != "https://git/issues" {
+			t.Fatalf("DiffProject = %+v, want Title and IssuesURL", d)
+		}
+		if d.Slug != "" || d.SourceURL != nil || d.Categories != nil || d.License != nil || d.ClientSide != "" {
+			t.Errorf("DiffProject has unchanged fields: %+v", d)
+		}
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		n := base()
+		n.WikiURL = nil
+		d := DiffProject(base(), n)
+		if d == nil || d.WikiURL == nil || *d.WikiURL != "" {
+			t.Fatalf("WikiURL = %v, want a pointer to empty", d)
+		}
+		if d.SourceURL != nil {
+			t.Errorf("SourceURL = %q, want nil", *d.SourceURL)
+		}
+	})
+
+	t.Run("license", func(t *testing.T) {
+		n := base()
+		n.License = &ProjectLicense{ID: "Apache-2.0", URL: ptr("https://mit")}
+		d := DiffProject(base(), n)
+		if d == nil || d.License == nil || d.License.ID != "Apache-2.0" || d.License.URL != nil {
+			t.Fatalf("License = %+v, want only ID", d)
+		}
+
+		n.License = &ProjectLicense{ID: "MIT"}
+		d = DiffProject(base(), n)
+		if d == nil || d.License == nil || d.License.ID != "" || d.License.URL == nil || *d.License.URL != "" {
+			t.Fatalf("License = %+v, want a cleared URL only", d)
+		}
+
+		// A removed license cannot be sent by Edit, so it is ignored.
+		n.License = nil
+		if d := DiffProject(base(), n); d != nil {
+			t.Errorf("DiffProject = %+v, want nil", d)
+		}
+	})
+
+	t.Run("slices", func(t *testing.T) {
+		n := base()
+		n.Categories = []string{"utility", "library"}
+		n.DonationUrls = []*ProjectDonationURL{{ID: "patreon", URL: "https://patreon"}}
+		d := DiffProject(base(), n)
+		if d == nil || len(d.Categories) != 2 {
+			t.Fatalf("Categories = %v, want the new ones", d)
+		}
+		if d.DonationUrls != nil {
+			t.Errorf("DonationUrls = %v, want nil for equal values", d.DonationUrls)
+		}
+
+		n = base()
+		n.DonationUrls[0] = &ProjectDonationURL{ID: "patreon", URL: "https://patreon/new"}
+		if d := DiffProject(base(), n); d == nil || len(d.DonationUrls) != 1 {
+			t.Errorf("DonationUrls = %v, want the new one", d)
+		}
+	})
+}
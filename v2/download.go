@@ -0,0 +1,134 @@
+package labrinth
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"time"
+)
+
+var ErrHashMismatch = errors.New("hash mismatch")
+
+// DownloadPrimaryFile downloads the primary file of v into w,
+// verifying the sha512 and sha1 hashes while writing.
+// It returns the number of bytes written, and ErrHashMismatch if the content differs.
+func (s *VersionsService) DownloadPrimaryFile(ctx context.Context, v *Version, w io.Writer) (int64, error) {
+	f := v.PrimaryFile()
+	if f == nil {
+		return 0, fmt.Errorf("version %s has no files", v.ID)
+	}
+
+	return s.client.downloadFile(ctx, f, w)
+}
+
+// Operation name of downloads for the tracer and metrics,
+// since file URLs are on the CDN rather than API routes.
+const (
+	downloadPathTemplate = "{file_url}"
+	downloadOperation    = http.MethodGet + " " + downloadPathTemplate
+)
+
+// downloadFile sends the request through the same retries, rate limiter, circuit breaker,
+// logger, tracer and metrics as API requests, but without the token or the client's
+// default headers, which are not meant for the CDN.
+func (c *Client) downloadFile(ctx context.Context, f *VersionFile, w io.Writer) (n int64, err error) {
+	end := func(error) {}
+	if c.tracer != nil {
+		ctx, end = c.tracer(ctx, downloadOperation)
+	}
+	start := time.Now()
+	status := 0
+	defer func() {
+		c.metrics.ObserveRequest(http.MethodGet, downloadPathTemplate, status, time.Since(start))
+		end(err)
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.URL, nil)
+	if err != nil {
+		return 0, err
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	res, err := c.send(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	status = res.StatusCode
+
+	if err := c.checkResponse(res); err != nil {
+		return 0, err
+	}
+
+	h := newFileHasher()
+	n, err = io.Copy(io.MultiWriter(w, h), res.Body)
+	if err != nil {
+		return n, err
+	}
+
+	return n, h.verify(f)
+}
+
+// fileHasher computes the hashes listed in VersionFile.Hashes at once.
+type fileHasher struct {
+	hashes map[string]hash.Hash
+	w      io.Writer
+}
+
+func newFileHasher() *fileHasher {
+	h := &fileHasher{
+		hashes: map[string]hash.Hash{
+			"sha1":   sha1.New(),
+			"sha512": sha512.New(),
+		},
+	}
+	h.w = io.MultiWriter(h.hashes["sha1"], h.hashes["sha512"])
+	return h
+}
+
+func (h *fileHasher) Write(p []byte) (int, error) {
+	return h.w.Write(p)
+}
+
+// verify compares the computed hashes with the expected ones of f.
+// Algorithms missing in f are not checked.
+func (h *fileHasher) verify(f *VersionFile) error {
+	for _, algo := range []string{"sha512", "sha1"} {
+		sum := h.hashes[algo]
+		want, ok := f.Hashes[algo]
+		if !ok {
+			continue
+		}
+		if got := hex.EncodeToString(sum.Sum(nil)); got != want {
+			return fmt.Errorf("%w: %s of %s is %s, want %s", ErrHashMismatch, algo, f.Filename, got, want)
+		}
+	}
+	return nil
+}
+
+// VerifyReader reads r to the end and reports whether its content matches
+// the sha1 and sha512 hashes of f. The content is streamed, not buffered.
+func (f *VersionFile) VerifyReader(r io.Reader) (bool, error) {
+	if f.Hashes["sha1"] == "" && f.Hashes["sha512"] == "" {
+		return false, fmt.Errorf("%s has no known hashes", f.Filename)
+	}
+
+	h := newFileHasher()
+	if _, err := io.Copy(h, r); err != nil {
+		return false, err
+	}
+
+	err := h.verify(f)
+	if errors.Is(err, ErrHashMismatch) {
+		return false, nil
+	}
+	return err == nil, err
+}
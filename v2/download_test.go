@@ -0,0 +1,118 @@
+package labrinth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type countingLimiter struct{ waits int }
+
+func (l *countingLimiter) Wait(ctx context.Context) error { l.waits++; return nil }
+func (l *countingLimiter) Update(rate Rate)               {}
+
+type recordedObservation struct {
+	method, path string
+	status       int
+}
+
+type observations []recordedObservation
+
+func (o *observations) ObserveRequest(method, pathTemplate string, status int, dur time.Duration) {
+	*o = append(*o, recordedObservation{method, pathTemplate, status})
+}
+
+func TestDownloadPrimaryFile(t *testing.T) {
+	content := []byte("jar content")
+	sha1sum, _ := ComputeHash(HashAlgorithm_SHA1, bytes.NewReader(content))
+	sha512sum, _ := ComputeHash(HashAlgorithm_SHA512, bytes.NewReader(content))
+
+	requests := 0
+	var auth, trace []string
+	cdn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		auth = append(auth, r.Header.Get("Authorization"))
+		trace = append(trace, r.Header.Get("X-Trace"))
+		if requests == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write(content)
+	}))
+	defer cdn.Close()
+
+	limiter := &countingLimiter{}
+	var obs observations
+	var ops []string
+	var logged int
+	c := NewClient(WithToken("mrp_token"), WithHeader("X-Trace", "1"),
+		WithRetry(&RetryPolicy{MaxRetries: 1, MaxWait: time.Millisecond})).
+		SetRateLimiter(limiter).
+		SetMetricsRecorder(&obs).
+		SetLogger(func(*http.Request, *http.Response, error, time.Duration) { logged++ }).
+		SetTracer(func(ctx context.Context, op string) (context.Context, func(error)) {
+			ops = append(ops, op)
+			return ctx, func(error) {}
+		})
+
+	v := &Version{ID: "v", Files: []*VersionFile{{
+		URL:      cdn.URL + "/data/abc/versions/v/mod.jar",
+		Filename: "mod.jar",
+		Primary:  true,
+		Hashes:   map[string]string{"sha1": sha1sum, "sha512": sha512sum},
+	}}}
+	buf := new(bytes.Buffer)
+	n, err := c.Versions.DownloadPrimaryFile(context.Background(), v, buf)
+	if err != nil {
+		t.Fatalf("DownloadPrimaryFile: %v", err)
+	}
+	if n != int64(len(content)) || !bytes.Equal(buf.Bytes(), content) {
+		t.Errorf("downloaded %d bytes %q, want %q", n, buf.Bytes(), content)
+	}
+
+	if requests != 2 || limiter.waits != 2 || logged != 2 {
+		t.Errorf("requests %d, limiter waits %d, logged %d, want 2 each", requests, limiter.waits, logged)
+	}
+	for i := range auth {
+		if auth[i] != "" || trace[i] != "" {
+			t.Errorf("request %d sent Authorization %q, X-Trace %q to the CDN", i, auth[i], trace[i])
+		}
+	}
+	if len(ops) != 1 || ops[0] != downloadOperation {
+		t.Errorf("traced %q, want %q", ops, downloadOperation)
+	}
+	if len(obs) != 1 || obs[0] != (recordedObservation{http.MethodGet, downloadPathTemplate, http.StatusOK}) {
+		t.Errorf("observed %v", obs)
+	}
+}
+
+func TestDownloadHashMismatch(t *testing.T) {
+	cdn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("tampered"))
+	}))
+	defer cdn.Close()
+
+	v := &Version{Files: []*VersionFile{{URL: cdn.URL + "/mod.jar", Filename: "mod.jar", Hashes: map[string]string{"sha1": "00"}}}}
+	_, err := NewClient().Versions.DownloadPrimaryFile(context.Background(), v, new(bytes.Buffer))
+	if !errors.Is(err, ErrHashMismatch) {
+		t.Errorf("err = %v, want ErrHashMismatch", err)
+	}
+}
+
+func TestDownloadCircuitOpen(t *testing.T) {
+	cdn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer cdn.Close()
+
+	c := NewClient().SetCircuitBreaker(NewCircuitBreaker(1, time.Hour))
+	v := &Version{Files: []*VersionFile{{URL: cdn.URL + "/mod.jar"}}}
+	c.Versions.DownloadPrimaryFile(context.Background(), v, new(bytes.Buffer))
+	if _, err := c.Versions.DownloadPrimaryFile(context.Background(), v, new(bytes.Buffer)); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("err = %v, want ErrCircuitOpen", err)
+	}
+}
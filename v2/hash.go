@@ -0,0 +1,62 @@
+package labrinth
+
+import (
+	"crypto/sha1"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"slices"
+	"strings"
+)
+
+type HashAlgorithm string
+
+const (
+	HashAlgorithm_SHA1   = HashAlgorithm("sha1")
+	HashAlgorithm_SHA512 = HashAlgorithm("sha512")
+)
+
+// DefaultHashAlgorithm is the algorithm the API uses when none is given.
+const DefaultHashAlgorithm = HashAlgorithm_SHA1
+
+var hashAlgorithms = []HashAlgorithm{
+	HashAlgorithm_SHA1,
+	HashAlgorithm_SHA512,
+}
+
+func (a HashAlgorithm) IsValid() bool {
+	return slices.Contains(hashAlgorithms, a)
+}
+
+// ParseHashAlgorithm parses s case-insensitively, e.g. "SHA512".
+func ParseHashAlgorithm(s string) (HashAlgorithm, error) {
+	a := HashAlgorithm(strings.ToLower(strings.TrimSpace(s)))
+	if !a.IsValid() {
+		return "", fmt.Errorf("unknown hash algorithm %q", s)
+	}
+	return a, nil
+}
+
+func (a HashAlgorithm) new() (hash.Hash, error) {
+	switch a {
+	case HashAlgorithm_SHA1:
+		return sha1.New(), nil
+	case HashAlgorithm_SHA512:
+		return sha512.New(), nil
+	}
+	return nil, fmt.Errorf("unknown hash algorithm %q", a)
+}
+
+// ComputeHash returns the hex digest of r, as used in VersionFile.Hashes.
+func ComputeHash(algo HashAlgorithm, r io.Reader) (string, error) {
+	h, err := algo.new()
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
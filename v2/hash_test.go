@@ -0,0 +1,44 @@
+package labrinth
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestComputeHash(t *testing.T) {
+	tests := []struct {
+		algo HashAlgorithm
+		in   string
+		want string
+	}{
+		{HashAlgorithm_SHA1, "", "da39a3ee5e6b4b0d3255bfef95601890afd80709"},
+		{HashAlgorithm_SHA1, "abc", "a9993e364706816aba3e25717850c26c9cd0d89d"},
+		{HashAlgorithm_SHA512, "", "cf83e1357eefb8bdf1542850d66d8007d620e4050b5715dc83f4a921d36ce9ce47d0d13c5d85f2b0ff8318d2877eec2f63b931bd47417a81a538327af927da3e"},
+		{HashAlgorithm_SHA512, "abc", "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f"},
+	}
+	for _, tt := range tests {
+		got, err := ComputeHash(tt.algo, strings.NewReader(tt.in))
+		if err != nil {
+			t.Errorf("ComputeHash(%s, %q): %v", tt.algo, tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ComputeHash(%s, %q) = %s, want %s", tt.algo, tt.in, got, tt.want)
+		}
+	}
+
+	if _, err := ComputeHash("md5", strings.NewReader("abc")); err == nil {
+		t.Error("ComputeHash(md5): want error")
+	}
+}
+
+func TestParseHashAlgorithm(t *testing.T) {
+	for in, want := range map[string]HashAlgorithm{"sha1": HashAlgorithm_SHA1, " SHA512 ": HashAlgorithm_SHA512} {
+		if got, err := ParseHashAlgorithm(in); err != nil || got != want {
+			t.Errorf("ParseHashAlgorithm(%q) = %s, %v, want %s", in, got, err, want)
+		}
+	}
+	if _, err := ParseHashAlgorithm("sha256"); err == nil {
+		t.Error("ParseHashAlgorithm(sha256): want error")
+	}
+}
@@ -0,0 +1,79 @@
+package labrinth
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+var ErrUnsupportedImageExt = errors.New("unsupported image file extension")
+
+func checkImageExt(ext string) error {
+	if !slices.Contains(supportedImageExt, ext) {
+		return fmt.Errorf("%w %q, must be one of: %s", ErrUnsupportedImageExt, ext, strings.Join(supportedImageExt, ", "))
+	}
+	return nil
+}
+
+// Content types detected by http.DetectContentType for each image extension.
+// Extensions missing here, like svg, cannot be sniffed and are trusted as is.
+var sniffableImageTypes = map[string]string{
+	"png":  "image/png",
+	"jpeg": "image/jpeg",
+	"gif":  "image/gif",
+	"bmp":  "image/bmp",
+	"webp": "image/webp",
+}
+
+// sniffImage checks that the content of r matches the image extension ext.
+// It returns a reader which yields the whole content of r, including the sniffed bytes.
+func sniffImage(ext string, r io.Reader) (io.Reader, error) {
+	want, ok := sniffableImageTypes[ext]
+	if !ok || r == nil {
+		return r, nil
+	}
+
+	// A Buffer is peeked instead of read, so that it stays a Buffer
+	// and http.NewRequest can still send it with a Content-Length.
+	if buf, ok := r.(*bytes.Buffer); ok {
+		if err := checkImageType(buf.Bytes()[:min(buf.Len(), 512)], want); err != nil {
+			return nil, err
+		}
+		return buf, nil
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return nil, err
+	}
+	head = head[:n]
+
+	if err := checkImageType(head, want); err != nil {
+		return nil, err
+	}
+
+	if s, ok := r.(io.Seeker); ok {
+		if _, err := s.Seek(int64(-n), io.SeekCurrent); err == nil {
+			return r, nil
+		}
+	}
+	return io.MultiReader(bytes.NewReader(head), r), nil
+}
+
+func checkImageType(head []byte, want string) error {
+	if got := http.DetectContentType(head); got != want {
+		return fmt.Errorf("image content is %s, not %s", got, want)
+	}
+	return nil
+}
+
+// imageExtFromPath returns the lower-cased extension of path without the dot.
+func imageExtFromPath(path string) string {
+	return strings.ToLower(strings.TrimPrefix(filepath.Ext(path), "."))
+}
@@ -0,0 +1,82 @@
+package labrinth
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var pngData = append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 600)...)
+
+func imageBodies(t *testing.T) map[string]func() io.Reader {
+	path := filepath.Join(t.TempDir(), "icon.png")
+	if err := os.WriteFile(path, pngData, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return map[string]func() io.Reader{
+		"Reader": func() io.Reader { return bytes.NewReader(pngData) },
+		"Buffer": func() io.Reader { return bytes.NewBuffer(append([]byte(nil), pngData...)) },
+		"File": func() io.Reader {
+			f, err := os.Open(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { f.Close() })
+			return f
+		},
+	}
+}
+
+func TestUploadContentLength(t *testing.T) {
+	c := NewClient()
+	for name, body := range imageBodies(t) {
+		r, err := sniffImage("png", body())
+		if err != nil {
+			t.Fatalf("%s: sniffImage: %v", name, err)
+		}
+		req, err := c.NewUploadRequest(http.MethodPatch, "project/x/icon?ext=png", "image/png", r)
+		if err != nil {
+			t.Fatalf("%s: NewUploadRequest: %v", name, err)
+		}
+		if req.ContentLength != int64(len(pngData)) {
+			t.Errorf("%s: ContentLength = %d, want %d", name, req.ContentLength, len(pngData))
+		}
+		data, _ := io.ReadAll(req.Body)
+		if !bytes.Equal(data, pngData) {
+			t.Errorf("%s: body differs from the image", name)
+		}
+	}
+}
+
+func TestChangeIconContentLength(t *testing.T) {
+	var length int64
+	var encoding []string
+	var data []byte
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		length, encoding = r.ContentLength, r.TransferEncoding
+		data, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	for name, body := range imageBodies(t) {
+		_, err := c.Projects.ChangeIcon(context.Background(), "x", &EditProjectIconParams{Ext: "png", File: body()})
+		if err != nil {
+			t.Fatalf("%s: ChangeIcon: %v", name, err)
+		}
+		if length != int64(len(pngData)) || len(encoding) != 0 {
+			t.Errorf("%s: ContentLength = %d, TransferEncoding = %q, want %d without chunked", name, length, encoding, len(pngData))
+		}
+		if !bytes.Equal(data, pngData) {
+			t.Errorf("%s: body differs from the image", name)
+		}
+	}
+}
+
+func TestSniffImageMismatch(t *testing.T) {
+	if _, err := sniffImage("png", bytes.NewBufferString("GIF89a")); err == nil {
+		t.Error("want error for gif content with png extension")
+	}
+}
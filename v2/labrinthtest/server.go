@@ -0,0 +1,228 @@
+// Package labrinthtest provides a fake API server for testing code using the client.
+package labrinthtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+
+	labrinth "labrinth/v2"
+)
+
+// Server is a fake API server.
+// Search, projects and versions are served from the data added with
+// AddProject and AddVersion, and any route can be overridden with Handle.
+type Server struct {
+	*httptest.Server
+	// Client is pointed at the server.
+	Client *labrinth.Client
+
+	mu       sync.Mutex
+	canned   map[string]http.HandlerFunc // by "METHOD /path"
+	projects []*labrinth.Project
+	versions []*labrinth.Version
+}
+
+// NewServer starts a server. Call Close when done.
+func NewServer() *Server {
+	s := &Server{
+		canned: map[string]http.HandlerFunc{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v2/search", s.search)
+	mux.HandleFunc("GET /v2/project/{id}", s.getProject)
+	mux.HandleFunc("GET /v2/projects", s.getProjects)
+	mux.HandleFunc("GET /v2/project/{id}/version", s.getProjectVersions)
+	mux.HandleFunc("GET /v2/version/{id}", s.getVersion)
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		h, ok := s.canned[r.Method+" "+r.URL.Path]
+		s.mu.Unlock()
+		if ok {
+			h(w, r)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	s.Client = labrinth.NewClient(labrinth.WithBaseURL(s.URL + "/v2"))
+	return s
+}
+
+// Handle responds to method and path with status and body encoded as JSON.
+// The path is relative to the API base, e.g. "project/abc".
+func (s *Server) Handle(method, path string, status int, body any) {
+	s.HandleFunc(method, path, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, status, body)
+	})
+}
+
+// HandleFunc responds to method and path with h.
+// The path is relative to the API base, e.g. "project/abc".
+func (s *Server) HandleFunc(method, path string, h http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.canned[method+" /v2/"+path] = h
+}
+
+// AddProject adds p to search results and project lookups by id or slug.
+func (s *Server) AddProject(p *labrinth.Project) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.projects = append(s.projects, p)
+}
+
+// AddVersion adds v to version lookups and the versions of its project.
+func (s *Server) AddVersion(v *labrinth.Version) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.versions = append(s.versions, v)
+}
+
+func (s *Server) search(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	offset, _ := strconv.Atoi(q.Get("offset"))
+	limit, err := strconv.Atoi(q.Get("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	s.mu.Lock()
+	hits := make([]*labrinth.SearchHit, 0, len(s.projects))
+	for _, p := range s.projects {
+		hits = append(hits, searchHit(p))
+	}
+	s.mu.Unlock()
+
+	total := len(hits)
+	offset = min(max(offset, 0), total)
+	end := min(offset+max(limit, 0), total)
+	writeJSON(w, http.StatusOK, &labrinth.SearchResult{
+		Hits:      hits[offset:end],
+		Offset:    offset,
+		Limit:     limit,
+		TotalHits: total,
+	})
+}
+
+func (s *Server) getProject(w http.ResponseWriter, r *http.Request) {
+	p := s.findProject(r.PathValue("id"))
+	if p == nil {
+		writeNotFound(w)
+		return
+	}
+	writeJSON(w, http.StatusOK, p)
+}
+
+func (s *Server) getProjects(w http.ResponseWriter, r *http.Request) {
+	var ids []string
+	if err := json.Unmarshal([]byte(r.URL.Query().Get("ids")), &ids); err != nil {
+		writeJSON(w, http.StatusBadRequest, &labrinth.ErrorResponse{Code: "invalid_input", Description: err.Error()})
+		return
+	}
+
+	projs := []*labrinth.Project{}
+	for _, id := range ids {
+		if p := s.findProject(id); p != nil {
+			projs = append(projs, p)
+		}
+	}
+	writeJSON(w, http.StatusOK, projs)
+}
+
+func (s *Server) getProjectVersions(w http.ResponseWriter, r *http.Request) {
+	p := s.findProject(r.PathValue("id"))
+	if p == nil {
+		writeNotFound(w)
+		return
+	}
+
+	s.mu.Lock()
+	versions := []*labrinth.Version{}
+	for _, v := range s.versions {
+		if v.ProjectID == p.ID {
+			versions = append(versions, v)
+		}
+	}
+	s.mu.Unlock()
+
+	// Offset and limit are only applied when given, as the API does.
+	q := r.URL.Query()
+	if offset, err := strconv.Atoi(q.Get("offset")); err == nil {
+		versions = versions[min(max(offset, 0), len(versions)):]
+	}
+	if limit, err := strconv.Atoi(q.Get("limit")); err == nil {
+		versions = versions[:min(max(limit, 0), len(versions))]
+	}
+	writeJSON(w, http.StatusOK, versions)
+}
+
+func (s *Server) getVersion(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, v := range s.versions {
+		if v.ID == id {
+			writeJSON(w, http.StatusOK, v)
+			return
+		}
+	}
+	writeNotFound(w)
+}
+
+func (s *Server) findProject(idSlug string) *labrinth.Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range s.projects {
+		if p.ID == idSlug || p.Slug == idSlug {
+			return p
+		}
+	}
+	return nil
+}
+
+func searchHit(p *labrinth.Project) *labrinth.SearchHit {
+	hit := &labrinth.SearchHit{
+		ProjectID:    p.ID,
+		ProjectType:  p.ProjectType,
+		Slug:         p.Slug,
+		Title:        p.Title,
+		Description:  p.Description,
+		Categories:   p.Categories,
+		Versions:     p.GameVersions,
+		Downloads:    p.Downloads,
+		Follows:      p.Followers,
+		DateCreated:  p.Published,
+		DateModified: p.Updated,
+		ClientSide:   p.ClientSide,
+		ServerSide:   p.ServerSide,
+		Color:        p.Color,
+	}
+	if p.IconURL != nil {
+		hit.IconURL = *p.IconURL
+	}
+	if p.License != nil {
+		hit.License = p.License.ID
+	}
+	if len(p.Versions) != 0 {
+		hit.LatestVersion = p.Versions[len(p.Versions)-1]
+	}
+	return hit
+}
+
+func writeNotFound(w http.ResponseWriter) {
+	writeJSON(w, http.StatusNotFound, &labrinth.ErrorResponse{
+		Code:        "not_found",
+		Description: "the requested route does not exist",
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,56 @@
+package labrinth
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Logger is called after each request completes.
+// res is nil when the request failed to get a response.
+type Logger func(req *http.Request, res *http.Response, err error, dur time.Duration)
+
+const redacted = "REDACTED"
+
+// SetLogger sets the hook called after each request.
+// The request passed to the hook has its Authorization header
+// and other headers with sensitive names redacted.
+func (c *Client) SetLogger(l Logger) *Client {
+	c.logger = l
+	return c
+}
+
+func (c *Client) log(req *http.Request, res *http.Response, err error, dur time.Duration) {
+	if c.logger == nil {
+		return
+	}
+	c.logger(redactRequest(req), res, err, dur)
+}
+
+// Substrings of header names whose values are redacted, matched case-insensitively.
+var sensitiveHeaderWords = []string{"authorization", "token", "secret", "key", "cookie", "password", "cf-access"}
+
+func isSensitiveHeader(name string) bool {
+	name = strings.ToLower(name)
+	return slices.ContainsFunc(sensitiveHeaderWords, func(w string) bool {
+		return strings.Contains(name, w)
+	})
+}
+
+func redactRequest(req *http.Request) *http.Request {
+	var r *http.Request
+	for k := range req.Header {
+		if !isSensitiveHeader(k) || req.Header.Get(k) == "" {
+			continue
+		}
+		if r == nil {
+			r = req.Clone(req.Context())
+		}
+		r.Header.Set(k, redacted)
+	}
+	if r == nil {
+		return req
+	}
+	return r
+}
@@ -0,0 +1,24 @@
+package labrinth
+
+import "time"
+
+// Recorder receives one observation per Do, for aggregation into metrics.
+// pathTemplate is the low-cardinality route such as "/project/{id}".
+// status is 0 when no response was received.
+type Recorder interface {
+	ObserveRequest(method, pathTemplate string, status int, dur time.Duration)
+}
+
+type nopRecorder struct{}
+
+func (nopRecorder) ObserveRequest(string, string, int, time.Duration) {}
+
+// SetMetricsRecorder sets the recorder observing each request.
+// A nil recorder disables it.
+func (c *Client) SetMetricsRecorder(r Recorder) *Client {
+	if r == nil {
+		r = nopRecorder{}
+	}
+	c.metrics = r
+	return c
+}
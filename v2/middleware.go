@@ -0,0 +1,35 @@
+package labrinth
+
+import "net/http"
+
+// Middleware wraps the transport used to send requests,
+// e.g. to add tracing, metrics or caching.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// Use appends middlewares to the transport chain.
+// The first middleware is the outermost, and the transport of the
+// HTTP client (see SetHTTPClient) is always the base of the chain.
+func (c *Client) Use(mw ...Middleware) *Client {
+	c.middlewares = append(c.middlewares, mw...)
+	c.buildTransport()
+	return c
+}
+
+// buildTransport rebuilds the client used by Do from hc and the middlewares.
+func (c *Client) buildTransport() {
+	if len(c.middlewares) == 0 {
+		c.transport = c.hc
+		return
+	}
+
+	hc := *c.hc
+	rt := hc.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		rt = c.middlewares[i](rt)
+	}
+	hc.Transport = rt
+	c.transport = &hc
+}
@@ -0,0 +1,26 @@
+package labrinth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type MiscService service
+
+// ForgeUpdates returns the project's versions in the Forge update checker format.
+// This endpoint lives at the host root, outside of the versioned API path.
+func (s *MiscService) ForgeUpdates(ctx context.Context, idSlug string) (*ForgeUpdates, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("/updates/%s/forge_updates.json", idSlug), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updates = new(ForgeUpdates)
+	res, err := s.client.Do(ctx, req, updates)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return updates, res, nil
+}
@@ -0,0 +1,7 @@
+package labrinth
+
+type ForgeUpdates struct {
+	Homepage string `json:"homepage"`
+	// Keys are "{mcversion}-latest" or "{mcversion}-recommended", values are version numbers.
+	Promos map[string]string `json:"promos"`
+}
@@ -0,0 +1,26 @@
+package labrinth
+
+type Notification struct {
+	ID      string                `json:"id"`
+	UserID  string                `json:"user_id"`
+	Type    *string               `json:"type"`
+	Title   string                `json:"title"`
+	Text    string                `json:"text"`
+	Link    string                `json:"link"`
+	Read    bool                  `json:"read"`
+	Created Time                  `json:"created"`
+	Actions []*NotificationAction `json:"actions"`
+}
+
+type NotificationAction struct {
+	Title string `json:"title"`
+	// Method and route of the action, e.g. ["POST", "team/{id}/join"].
+	ActionRoute []string `json:"action_route"`
+}
+
+// NotificationEvent is sent by NotificationsService.Poll.
+// Either Notification or Err is set.
+type NotificationEvent struct {
+	Notification *Notification
+	Err          error
+}
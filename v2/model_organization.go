@@ -0,0 +1,12 @@
+package labrinth
+
+type Organization struct {
+	ID          string        `json:"id"`
+	Slug        string        `json:"slug"`
+	Name        string        `json:"name"`
+	TeamID      string        `json:"team_id"`
+	Description string        `json:"description"`
+	IconURL     *string       `json:"icon_url"`
+	Color       *int          `json:"color"`
+	Members     []*TeamMember `json:"members"`
+}
@@ -1,8 +1,9 @@
 package labrinth
 
 import (
+	"encoding/json"
+	"fmt"
 	"slices"
-	"time"
 )
 
 type Project struct {
@@ -32,10 +33,10 @@ type Project struct {
 	ID                   string                `json:"id"`
 	Team                 string                `json:"team"`
 	Organization         *string               `json:"organization"`
-	Published            time.Time             `json:"published"`
-	Updated              time.Time             `json:"updated"`
-	Approved             *time.Time            `json:"approved"`
-	Queued               *time.Time            `json:"queued"`
+	Published            Time                  `json:"published"`
+	Updated              Time                  `json:"updated"`
+	Approved             Time                  `json:"approved"` // Zero if the project has not been approved.
+	Queued               Time                  `json:"queued"`   // Zero if the project has not been queued.
 	Followers            int                   `json:"followers"`
 	License              *ProjectLicense       `json:"license"`
 	Versions             []string              `json:"versions"`
@@ -44,6 +45,36 @@ type Project struct {
 	Gallery              []*GalleryImage       `json:"gallery"`
 }
 
+// MarshalJSON omits the deprecated BodyURL and ModeratorMessage fields,
+// so that a marshaled Project never sends them back to the API.
+func (p Project) MarshalJSON() ([]byte, error) {
+	type project Project
+	return json.Marshal(&struct {
+		project
+		BodyURL          *struct{} `json:"body_url,omitempty"`
+		ModeratorMessage *struct{} `json:"moderator_message,omitempty"`
+	}{project: project(p)})
+}
+
+// RGBColor unpacks Color, e.g. 0xFF8800 is (255, 136, 0).
+// ok is false when the project has no color.
+func (p *Project) RGBColor() (r, g, b uint8, ok bool) {
+	if p.Color == nil {
+		return 0, 0, 0, false
+	}
+	c := *p.Color
+	return uint8(c >> 16), uint8(c >> 8), uint8(c), true
+}
+
+// HexColor returns Color as "#rrggbb", or "" when the project has no color.
+func (p *Project) HexColor() string {
+	r, g, b, ok := p.RGBColor()
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
 type ProjectSideSupport string
 
 const (
@@ -53,6 +84,38 @@ const (
 	ProjectSideSupport_Unknown     = ProjectSideSupport("unknown")
 )
 
+var projectSideSupports = []ProjectSideSupport{
+	ProjectSideSupport_Required,
+	ProjectSideSupport_Optional,
+	ProjectSideSupport_Unsupported,
+	ProjectSideSupport_Unknown,
+}
+
+func (s ProjectSideSupport) IsValid() bool {
+	return slices.Contains(projectSideSupports, s)
+}
+
+// ParseProjectSideSupport maps unknown input to ProjectSideSupport_Unknown.
+func ParseProjectSideSupport(s string) ProjectSideSupport {
+	return parseEnum(s, projectSideSupports, ProjectSideSupport_Unknown)
+}
+
+var projectSideSupportNames = map[ProjectSideSupport]string{
+	ProjectSideSupport_Required:    "Required",
+	ProjectSideSupport_Optional:    "Optional",
+	ProjectSideSupport_Unsupported: "Unsupported",
+	ProjectSideSupport_Unknown:     "Unknown",
+}
+
+func (s ProjectSideSupport) String() string {
+	return string(s)
+}
+
+// GoString returns the constant name, e.g. labrinth.ProjectSideSupport_Required.
+func (s ProjectSideSupport) GoString() string {
+	return goStringEnum(s, "ProjectSideSupport", projectSideSupportNames)
+}
+
 type ProjectStatus string
 
 const (
@@ -74,6 +137,50 @@ func (s ProjectStatus) IsRequestable() bool {
 	return slices.Contains(requestableProjectStatus, string(s))
 }
 
+var projectStatuses = []ProjectStatus{
+	ProjectStatus_Approved,
+	ProjectStatus_Archived,
+	ProjectStatus_Rejected,
+	ProjectStatus_Draft,
+	ProjectStatus_Unlisted,
+	ProjectStatus_Processing,
+	ProjectStatus_Withheld,
+	ProjectStatus_Scheduled,
+	ProjectStatus_Private,
+	ProjectStatus_Unknown,
+}
+
+func (s ProjectStatus) IsValid() bool {
+	return slices.Contains(projectStatuses, s)
+}
+
+// ParseProjectStatus maps unknown input to ProjectStatus_Unknown.
+func ParseProjectStatus(s string) ProjectStatus {
+	return parseEnum(s, projectStatuses, ProjectStatus_Unknown)
+}
+
+var projectStatusNames = map[ProjectStatus]string{
+	ProjectStatus_Approved:   "Approved",
+	ProjectStatus_Archived:   "Archived",
+	ProjectStatus_Rejected:   "Rejected",
+	ProjectStatus_Draft:      "Draft",
+	ProjectStatus_Unlisted:   "Unlisted",
+	ProjectStatus_Processing: "Processing",
+	ProjectStatus_Withheld:   "Withheld",
+	ProjectStatus_Scheduled:  "Scheduled",
+	ProjectStatus_Private:    "Private",
+	ProjectStatus_Unknown:    "Unknown",
+}
+
+func (s ProjectStatus) String() string {
+	return string(s)
+}
+
+// GoString returns the constant name, e.g. labrinth.ProjectStatus_Approved.
+func (s ProjectStatus) GoString() string {
+	return goStringEnum(s, "ProjectStatus", projectStatusNames)
+}
+
 type ProjectDonationURL struct {
 	ID       string `json:"id"`
 	Platform string `json:"platform"`
@@ -90,14 +197,97 @@ const (
 	ProjectType_Unknown      = ProjectType("project")
 )
 
+var projectTypes = []ProjectType{
+	ProjectType_Mod,
+	ProjectType_Modpack,
+	ProjectType_Resourcepack,
+	ProjectType_Shader,
+	ProjectType_Unknown,
+}
+
+func (t ProjectType) IsValid() bool {
+	return slices.Contains(projectTypes, t)
+}
+
+// ParseProjectType maps unknown input to ProjectType_Unknown.
+func ParseProjectType(s string) ProjectType {
+	return parseEnum(s, projectTypes, ProjectType_Unknown)
+}
+
+var projectTypeNames = map[ProjectType]string{
+	ProjectType_Mod:          "Mod",
+	ProjectType_Modpack:      "Modpack",
+	ProjectType_Resourcepack: "Resourcepack",
+	ProjectType_Shader:       "Shader",
+	ProjectType_Unknown:      "Unknown",
+}
+
+func (t ProjectType) String() string {
+	return string(t)
+}
+
+// GoString returns the constant name, e.g. labrinth.ProjectType_Mod.
+func (t ProjectType) GoString() string {
+	return goStringEnum(t, "ProjectType", projectTypeNames)
+}
+
 type MonetizationStatus string
 
 const (
 	MonetizationStatus_Monetized        = MonetizationStatus("monetized")
 	MonetizationStatus_Demonetized      = MonetizationStatus("demonetized")
 	MonetizationStatus_ForceDemonetized = MonetizationStatus("force-demonetized")
+	// Not sent by the API; ParseMonetizationStatus returns it for unknown input.
+	MonetizationStatus_Unknown = MonetizationStatus("unknown")
 )
 
+var monetizationStatuses = []MonetizationStatus{
+	MonetizationStatus_Monetized,
+	MonetizationStatus_Demonetized,
+	MonetizationStatus_ForceDemonetized,
+	MonetizationStatus_Unknown,
+}
+
+func (s MonetizationStatus) IsValid() bool {
+	return slices.Contains(monetizationStatuses, s)
+}
+
+// ParseMonetizationStatus maps unknown input to MonetizationStatus_Unknown.
+func ParseMonetizationStatus(s string) MonetizationStatus {
+	return parseEnum(s, monetizationStatuses, MonetizationStatus_Unknown)
+}
+
+var monetizationStatusNames = map[MonetizationStatus]string{
+	MonetizationStatus_Monetized:        "Monetized",
+	MonetizationStatus_Demonetized:      "Demonetized",
+	MonetizationStatus_ForceDemonetized: "ForceDemonetized",
+	MonetizationStatus_Unknown:          "Unknown",
+}
+
+func (s MonetizationStatus) String() string {
+	return string(s)
+}
+
+// GoString returns the constant name, e.g. labrinth.MonetizationStatus_Monetized.
+func (s MonetizationStatus) GoString() string {
+	return goStringEnum(s, "MonetizationStatus", monetizationStatusNames)
+}
+
+func parseEnum[T ~string](s string, known []T, unknown T) T {
+	if slices.Contains(known, T(s)) {
+		return T(s)
+	}
+	return unknown
+}
+
+// goStringEnum returns the name of the constant v, or a conversion for an unknown value.
+func goStringEnum[T ~string](v T, typeName string, names map[T]string) string {
+	if name, ok := names[v]; ok {
+		return "labrinth." + typeName + "_" + name
+	}
+	return fmt.Sprintf("labrinth.%s(%q)", typeName, string(v))
+}
+
 const (
 	ProjectLicenseID_Unknown = "LicenseRef-Unknown"
 )
@@ -109,10 +299,10 @@ type ProjectLicense struct {
 }
 
 type GalleryImage struct {
-	URL         string    `json:"url"`
-	Featured    bool      `json:"featured"`
-	Title       *string   `json:"title"`
-	Description *string   `json:"description"`
-	Created     time.Time `json:"created"`
-	Ordering    int       `json:"ordering"`
+	URL         string  `json:"url"`
+	Featured    bool    `json:"featured"`
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	Created     Time    `json:"created"`
+	Ordering    int     `json:"ordering"`
 }
@@ -0,0 +1,97 @@
+package labrinth
+
+import (
+	"fmt"
+	"testing"
+)
+
+type validator interface{ IsValid() bool }
+
+func TestParseEnums(t *testing.T) {
+	for _, v := range projectSideSupports {
+		if got := ParseProjectSideSupport(string(v)); got != v || !v.IsValid() {
+			t.Errorf("ParseProjectSideSupport(%q) = %q, valid %v", v, got, v.IsValid())
+		}
+	}
+	for _, v := range projectStatuses {
+		if got := ParseProjectStatus(string(v)); got != v || !v.IsValid() {
+			t.Errorf("ParseProjectStatus(%q) = %q, valid %v", v, got, v.IsValid())
+		}
+	}
+	for _, v := range projectTypes {
+		if got := ParseProjectType(string(v)); got != v || !v.IsValid() {
+			t.Errorf("ParseProjectType(%q) = %q, valid %v", v, got, v.IsValid())
+		}
+	}
+	for _, v := range monetizationStatuses {
+		if got := ParseMonetizationStatus(string(v)); got != v || !v.IsValid() {
+			t.Errorf("ParseMonetizationStatus(%q) = %q, valid %v", v, got, v.IsValid())
+		}
+	}
+
+	for _, s := range []string{"", "Mod", "bogus", " required"} {
+		if got := ParseProjectSideSupport(s); got != ProjectSideSupport_Unknown {
+			t.Errorf("ParseProjectSideSupport(%q) = %q", s, got)
+		}
+		if got := ParseProjectStatus(s); got != ProjectStatus_Unknown {
+			t.Errorf("ParseProjectStatus(%q) = %q", s, got)
+		}
+		if got := ParseProjectType(s); got != ProjectType_Unknown {
+			t.Errorf("ParseProjectType(%q) = %q", s, got)
+		}
+		if got := ParseMonetizationStatus(s); got != MonetizationStatus_Unknown {
+			t.Errorf("ParseMonetizationStatus(%q) = %q", s, got)
+		}
+		if ProjectSideSupport(s).IsValid() || ProjectStatus(s).IsValid() || ProjectType(s).IsValid() || MonetizationStatus(s).IsValid() {
+			t.Errorf("%q is valid", s)
+		}
+	}
+	for _, v := range []validator{ProjectSideSupport_Unknown, ProjectStatus_Unknown, ProjectType_Unknown, MonetizationStatus_Unknown} {
+		if !v.IsValid() {
+			t.Errorf("%#v is not valid, so a parsed unknown value fails validation", v)
+		}
+	}
+}
+
+func TestEnumStrings(t *testing.T) {
+	tests := []struct {
+		v     any
+		str   string
+		gostr string
+	}{
+		{ProjectType_Mod, "mod", "labrinth.ProjectType_Mod"},
+		{ProjectType_Unknown, "project", "labrinth.ProjectType_Unknown"},
+		{ProjectType("plugin"), "plugin", `labrinth.ProjectType("plugin")`},
+		{ProjectSideSupport_Unsupported, "unsupported", "labrinth.ProjectSideSupport_Unsupported"},
+		{ProjectStatus_Withheld, "withheld", "labrinth.ProjectStatus_Withheld"},
+		{MonetizationStatus_ForceDemonetized, "force-demonetized", "labrinth.MonetizationStatus_ForceDemonetized"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprint(tt.v); got != tt.str {
+			t.Errorf("%%v = %s, want %s", got, tt.str)
+		}
+		if got := fmt.Sprintf("%#v", tt.v); got != tt.gostr {
+			t.Errorf("%%#v = %s, want %s", got, tt.gostr)
+		}
+	}
+}
+
+func TestProjectColor(t *testing.T) {
+	color := 0xFF8800
+	p := &Project{Color: &color}
+	r, g, b, ok := p.RGBColor()
+	if !ok || r != 0xFF || g != 0x88 || b != 0x00 {
+		t.Errorf("RGBColor() = %d, %d, %d, %v, want 255, 136, 0, true", r, g, b, ok)
+	}
+	if got := p.HexColor(); got != "#ff8800" {
+		t.Errorf("HexColor() = %q, want #ff8800", got)
+	}
+
+	none := &Project{}
+	if _, _, _, ok := none.RGBColor(); ok {
+		t.Error("RGBColor() ok for nil Color")
+	}
+	if got := none.HexColor(); got != "" {
+		t.Errorf("HexColor() = %q for nil Color", got)
+	}
+}
@@ -0,0 +1,21 @@
+package labrinth
+
+type Report struct {
+	ID         string         `json:"id"`
+	ReportType string         `json:"report_type"`
+	ItemID     string         `json:"item_id"`
+	ItemType   ReportItemType `json:"item_type"`
+	Body       string         `json:"body"`
+	Reporter   string         `json:"reporter"`
+	Created    Time           `json:"created"`
+	Closed     bool           `json:"closed"`
+	ThreadID   string         `json:"thread_id"`
+}
+
+type ReportItemType string
+
+const (
+	ReportItemType_Project = ReportItemType("project")
+	ReportItemType_Version = ReportItemType("version")
+	ReportItemType_User    = ReportItemType("user")
+)
@@ -0,0 +1,49 @@
+package labrinth
+
+type SearchResult struct {
+	Hits      []*SearchHit `json:"hits"`
+	Offset    int          `json:"offset"`
+	Limit     int          `json:"limit"`
+	TotalHits int          `json:"total_hits"`
+}
+
+// HasMore reports whether there are hits after this page.
+func (r *SearchResult) HasMore() bool {
+	return r.NextOffset() < r.TotalHits
+}
+
+// NextOffset returns the offset of the page following this one.
+func (r *SearchResult) NextOffset() int {
+	return r.Offset + len(r.Hits)
+}
+
+// SearchHit is a reduced projection of Project in the search index.
+type SearchHit struct {
+	ProjectID         string             `json:"project_id"`
+	ProjectType       ProjectType        `json:"project_type"`
+	Slug              string             `json:"slug"`
+	Author            string             `json:"author"`
+	Title             string             `json:"title"`
+	Description       string             `json:"description"`
+	Categories        []string           `json:"categories"`
+	DisplayCategories []string           `json:"display_categories"`
+	Versions          []string           `json:"versions"`
+	Downloads         int                `json:"downloads"`
+	Follows           int                `json:"follows"`
+	IconURL           string             `json:"icon_url"`
+	DateCreated       Time               `json:"date_created"`
+	DateModified      Time               `json:"date_modified"`
+	LatestVersion     string             `json:"latest_version"`
+	License           string             `json:"license"`
+	ClientSide        ProjectSideSupport `json:"client_side"`
+	ServerSide        ProjectSideSupport `json:"server_side"`
+	Gallery           []string           `json:"gallery"`
+	FeaturedGallery   *string            `json:"featured_gallery"`
+	Color             *int               `json:"color"`
+}
+
+// ID returns the project id of the hit, matching Project.ID.
+// (The field is named ProjectID, so a method of that name is not possible.)
+func (h *SearchHit) ID() string {
+	return h.ProjectID
+}
@@ -0,0 +1,28 @@
+package labrinth
+
+import "testing"
+
+func TestSearchResultHasMore(t *testing.T) {
+	tests := []struct {
+		name   string
+		offset int
+		hits   int
+		total  int
+		want   bool
+	}{
+		{"before the end", 0, 10, 25, true},
+		{"last full page", 10, 10, 20, false},
+		{"short last page", 20, 5, 25, false},
+		{"one left", 10, 10, 21, true},
+		{"empty", 0, 0, 0, false},
+	}
+	for _, tt := range tests {
+		r := &SearchResult{Hits: make([]*SearchHit, tt.hits), Offset: tt.offset, Limit: 10, TotalHits: tt.total}
+		if got := r.HasMore(); got != tt.want {
+			t.Errorf("%s: HasMore() = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := r.NextOffset(); got != tt.offset+tt.hits {
+			t.Errorf("%s: NextOffset() = %d, want %d", tt.name, got, tt.offset+tt.hits)
+		}
+	}
+}
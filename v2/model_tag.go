@@ -0,0 +1,13 @@
+package labrinth
+
+type DonationPlatform struct {
+	Short string `json:"short"`
+	Name  string `json:"name"`
+}
+
+type Category struct {
+	Icon        string      `json:"icon"` // SVG
+	Name        string      `json:"name"`
+	ProjectType ProjectType `json:"project_type"`
+	Header      string      `json:"header"`
+}
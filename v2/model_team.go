@@ -0,0 +1,40 @@
+package labrinth
+
+import "github.com/shopspring/decimal"
+
+type TeamMember struct {
+	TeamID       string             `json:"team_id"`
+	User         *User              `json:"user"`
+	Role         string             `json:"role"`
+	Permissions  ProjectPermissions `json:"permissions"` // Zero unless the authorized user is in the team.
+	Accepted     bool               `json:"accepted"`
+	PayoutsSplit decimal.Decimal    `json:"payouts_split"`
+	Ordering     int                `json:"ordering"`
+}
+
+// ProjectPermissions is a bitfield of what a team member is allowed to do.
+type ProjectPermissions uint64
+
+const (
+	ProjectPermission_UploadVersion ProjectPermissions = 1 << iota
+	ProjectPermission_DeleteVersion
+	ProjectPermission_EditDetails
+	ProjectPermission_EditBody
+	ProjectPermission_ManageInvites
+	ProjectPermission_RemoveMember
+	ProjectPermission_EditMember
+	ProjectPermission_DeleteProject
+	ProjectPermission_ViewAnalytics
+	ProjectPermission_ViewPayouts
+
+	projectPermissionAll = ProjectPermission_ViewPayouts<<1 - 1
+)
+
+func (p ProjectPermissions) Has(perm ProjectPermissions) bool {
+	return p&perm == perm
+}
+
+// IsValid reports whether p contains only known permission bits.
+func (p ProjectPermissions) IsValid() bool {
+	return p&^projectPermissionAll == 0
+}
@@ -0,0 +1,15 @@
+package labrinth
+
+type ThreadMessageBody struct {
+	Type ThreadMessageType `json:"type"`
+	Body string            `json:"body,omitempty"`
+}
+
+type ThreadMessageType string
+
+const (
+	ThreadMessageType_Text          = ThreadMessageType("text")
+	ThreadMessageType_StatusChange  = ThreadMessageType("status_change")
+	ThreadMessageType_ThreadClosure = ThreadMessageType("thread_closure")
+	ThreadMessageType_Deleted       = ThreadMessageType("deleted")
+)
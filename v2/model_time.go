@@ -0,0 +1,46 @@
+package labrinth
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+)
+
+// Time is a time.Time decoded leniently from the API:
+// null and "" decode to the zero time, and fractional seconds
+// or a missing time zone (read as UTC) are accepted.
+type Time struct {
+	time.Time
+}
+
+// Layouts tried in order; the last one has no time zone.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	var err error
+	for _, layout := range timeLayouts {
+		var parsed time.Time
+		if parsed, err = time.Parse(layout, s); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+	return err
+}
@@ -0,0 +1,60 @@
+package labrinth
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimeUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{`null`, time.Time{}},
+		{`""`, time.Time{}},
+		{`"2023-06-01T12:34:56Z"`, time.Date(2023, 6, 1, 12, 34, 56, 0, time.UTC)},
+		{`"2023-06-01T12:34:56.123456Z"`, time.Date(2023, 6, 1, 12, 34, 56, 123456000, time.UTC)},
+		{`"2023-06-01T21:34:56+09:00"`, time.Date(2023, 6, 1, 12, 34, 56, 0, time.UTC)},
+		{`"2023-06-01T12:34:56.5"`, time.Date(2023, 6, 1, 12, 34, 56, 500000000, time.UTC)},
+	}
+	for _, tt := range tests {
+		var got Time
+		if err := json.Unmarshal([]byte(tt.in), &got); err != nil {
+			t.Errorf("Unmarshal(%s): %v", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("Unmarshal(%s) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	for _, in := range []string{`"yesterday"`, `123`} {
+		var got Time
+		if err := json.Unmarshal([]byte(in), &got); err == nil {
+			t.Errorf("Unmarshal(%s): want error", in)
+		}
+	}
+}
+
+func TestProjectApprovedQueued(t *testing.T) {
+	tests := []struct {
+		in     string
+		isZero bool
+	}{
+		{`{"approved":null,"queued":null}`, true},
+		{`{"approved":"","queued":""}`, true},
+		{`{}`, true},
+		{`{"approved":"2023-06-01T12:34:56Z","queued":"2023-06-01T12:34:56Z"}`, false},
+	}
+	for _, tt := range tests {
+		var p Project
+		if err := json.Unmarshal([]byte(tt.in), &p); err != nil {
+			t.Errorf("Unmarshal(%s): %v", tt.in, err)
+			continue
+		}
+		if p.Approved.IsZero() != tt.isZero || p.Queued.IsZero() != tt.isZero {
+			t.Errorf("Unmarshal(%s): Approved %v, Queued %v, want zero %v", tt.in, p.Approved, p.Queued, tt.isZero)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package labrinth
+
+type User struct {
+	ID        string   `json:"id"`
+	Username  string   `json:"username"`
+	Name      *string  `json:"name"`
+	Email     *string  `json:"email"`
+	Bio       *string  `json:"bio"`
+	AvatarURL string   `json:"avatar_url"`
+	Created   Time     `json:"created"`
+	Role      UserRole `json:"role"`
+	Badges    int      `json:"badges"`
+	GithubID  *int     `json:"github_id"` // Deprecated: Allways null.
+}
+
+type UserRole string
+
+const (
+	UserRole_Admin     = UserRole("admin")
+	UserRole_Moderator = UserRole("moderator")
+	UserRole_Developer = UserRole("developer")
+)
@@ -0,0 +1,88 @@
+package labrinth
+
+import "slices"
+
+type Version struct {
+	ID              string               `json:"id"`
+	ProjectID       string               `json:"project_id"`
+	AuthorID        string               `json:"author_id"`
+	Featured        bool                 `json:"featured"`
+	Name            string               `json:"name"`
+	VersionNumber   string               `json:"version_number"`
+	Changelog       *string              `json:"changelog"`
+	ChangelogURL    *string              `json:"changelog_url"` // Deprecated: Allways null.
+	DatePublished   Time                 `json:"date_published"`
+	Downloads       int                  `json:"downloads"`
+	VersionType     VersionType          `json:"version_type"`
+	Status          VersionStatus        `json:"status"`
+	RequestedStatus *VersionStatus       `json:"requested_status"`
+	Files           []*VersionFile       `json:"files"`
+	Dependencies    []*VersionDependency `json:"dependencies"`
+	Loaders         []string             `json:"loaders"`
+	GameVersions    []string             `json:"game_versions"`
+}
+
+type VersionType string
+
+const (
+	VersionType_Release = VersionType("release")
+	VersionType_Beta    = VersionType("beta")
+	VersionType_Alpha   = VersionType("alpha")
+)
+
+type VersionStatus string
+
+const (
+	VersionStatus_Listed    = VersionStatus("listed")
+	VersionStatus_Archived  = VersionStatus("archived")
+	VersionStatus_Draft     = VersionStatus("draft")
+	VersionStatus_Unlisted  = VersionStatus("unlisted")
+	VersionStatus_Scheduled = VersionStatus("scheduled")
+	VersionStatus_Unknown   = VersionStatus("unknown")
+)
+
+var requestableVersionStatus = []string{"listed", "archived", "draft", "unlisted"}
+
+func (s VersionStatus) IsRequestable() bool {
+	return slices.Contains(requestableVersionStatus, string(s))
+}
+
+type VersionFile struct {
+	// Keys are hash algorithms, "sha1" and "sha512".
+	Hashes   map[string]string `json:"hashes"`
+	URL      string            `json:"url"`
+	Filename string            `json:"filename"`
+	Primary  bool              `json:"primary"`
+	Size     int64             `json:"size"`
+	FileType *string           `json:"file_type"` // "required-resource-pack", "optional-resource-pack" or null.
+}
+
+type VersionDependency struct {
+	VersionID      *string        `json:"version_id"`
+	ProjectID      *string        `json:"project_id"`
+	FileName       *string        `json:"file_name"`
+	DependencyType DependencyType `json:"dependency_type"`
+}
+
+type DependencyType string
+
+const (
+	DependencyType_Required     = DependencyType("required")
+	DependencyType_Optional     = DependencyType("optional")
+	DependencyType_Incompatible = DependencyType("incompatible")
+	DependencyType_Embedded     = DependencyType("embedded")
+)
+
+// PrimaryFile returns the file marked as primary, or the first file if none is.
+// It returns nil if the version has no files.
+func (v *Version) PrimaryFile() *VersionFile {
+	for _, f := range v.Files {
+		if f.Primary {
+			return f
+		}
+	}
+	if len(v.Files) != 0 {
+		return v.Files[0]
+	}
+	return nil
+}
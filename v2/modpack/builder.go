@@ -0,0 +1,275 @@
+package modpack
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	labrinth "labrinth/v2"
+
+	"github.com/samber/lo"
+)
+
+var (
+	ErrNoDownloadURL = errors.New("file has no download url")
+	ErrMissingHash   = errors.New("file is missing a sha1 or sha512 hash")
+)
+
+// Loaders by their dependency ID, in the order they are looked up.
+var loaderDependencies = []struct {
+	id     string
+	loader string
+}{
+	{Dependency_FabricLoader, "fabric"},
+	{Dependency_QuiltLoader, "quilt"},
+	{Dependency_Forge, "forge"},
+	{Dependency_NeoForge, "neoforge"},
+}
+
+var shaderLoaders = []string{"iris", "optifine", "canvas"}
+
+// Builder assembles a .mrpack from Modrinth versions.
+//
+//	b := modpack.NewBuilder(client, "My Pack", "1.0.0").
+//		SetDependency(modpack.Dependency_Minecraft, "1.20.1").
+//		SetDependency(modpack.Dependency_FabricLoader, "0.15.11").
+//		AddProject("sodium").
+//		AddVersion("IZskON6d")
+//	idx, err := b.Write(ctx, w)
+type Builder struct {
+	c          *labrinth.Client
+	index      Index
+	selections []selection
+	overrides  []override
+}
+
+// selection is either a project, resolved to its latest release, or a version.
+type selection struct {
+	projectIDSlug string
+	versionID     string
+}
+
+type override struct {
+	path string
+	data []byte
+}
+
+func NewBuilder(c *labrinth.Client, name, versionID string) *Builder {
+	return &Builder{
+		c: c,
+		index: Index{
+			FormatVersion: formatVersion,
+			Game:          gameMinecraft,
+			VersionID:     versionID,
+			Name:          name,
+			Dependencies:  map[string]string{},
+		},
+	}
+}
+
+func (b *Builder) SetSummary(summary string) *Builder {
+	b.index.Summary = summary
+	return b
+}
+
+// SetDependency sets the version of the game or a loader, e.g. Dependency_Minecraft.
+func (b *Builder) SetDependency(id, version string) *Builder {
+	b.index.Dependencies[id] = version
+	return b
+}
+
+// AddVersion adds the primary file of a version.
+func (b *Builder) AddVersion(versionID string) *Builder {
+	b.selections = append(b.selections, selection{versionID: versionID})
+	return b
+}
+
+// AddProject adds the latest release of a project for the Minecraft version
+// and loader set with SetDependency.
+func (b *Builder) AddProject(idSlug string) *Builder {
+	b.selections = append(b.selections, selection{projectIDSlug: idSlug})
+	return b
+}
+
+// AddOverride adds a file copied into the instance at path, e.g. "config/sodium.json".
+func (b *Builder) AddOverride(path string, data []byte) *Builder {
+	b.overrides = append(b.overrides, override{path: path, data: data})
+	return b
+}
+
+// Build resolves the selections and returns the index.
+// Every problem found is returned at once, joined with errors.Join.
+func (b *Builder) Build(ctx context.Context) (*Index, error) {
+	idx := b.index
+	idx.Dependencies = lo.Assign(b.index.Dependencies)
+	idx.Files = []*File{}
+
+	var errs []error
+	if idx.Dependencies[Dependency_Minecraft] == "" {
+		errs = append(errs, errors.New("minecraft dependency is required"))
+	}
+
+	seen := map[string]bool{}
+	paths := map[string]bool{}
+	for _, sel := range b.selections {
+		v, err := b.resolve(ctx, sel)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if seen[v.ID] {
+			continue
+		}
+		seen[v.ID] = true
+
+		f, err := fileFromVersion(v)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if paths[f.Path] {
+			errs = append(errs, fmt.Errorf("version %s: duplicated path %s", v.ID, f.Path))
+			continue
+		}
+		paths[f.Path] = true
+		idx.Files = append(idx.Files, f)
+	}
+	for _, o := range b.overrides {
+		if _, err := overridePath(o.path); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return &idx, nil
+}
+
+func (b *Builder) resolve(ctx context.Context, sel selection) (*labrinth.Version, error) {
+	if sel.versionID != "" {
+		v, _, err := b.c.Versions.Get(ctx, sel.versionID)
+		if err != nil {
+			return nil, fmt.Errorf("version %s: %w", sel.versionID, err)
+		}
+		return v, nil
+	}
+
+	gameVersion := b.index.Dependencies[Dependency_Minecraft]
+	loader := b.loader()
+	params := &labrinth.ListProjectVersionsParams{}
+	if gameVersion != "" {
+		params.GameVersions = []string{gameVersion}
+	}
+	vs, _, err := b.c.Projects.GetVersions(ctx, sel.projectIDSlug, params)
+	if err != nil {
+		return nil, fmt.Errorf("project %s: %w", sel.projectIDSlug, err)
+	}
+	// Resource packs and shaders have no mod loader, so only mods are filtered by it.
+	v := labrinth.LatestFor(vs, loader, gameVersion)
+	if v == nil {
+		v = labrinth.LatestFor(lo.Filter(vs, func(v *labrinth.Version, _ int) bool {
+			return fileDir(v) != "mods/"
+		}), "", gameVersion)
+	}
+	if v == nil {
+		return nil, fmt.Errorf("project %s: no release for %s %s", sel.projectIDSlug, loader, gameVersion)
+	}
+	return v, nil
+}
+
+// loader returns the mod loader set with SetDependency, or "" if none is.
+func (b *Builder) loader() string {
+	for _, d := range loaderDependencies {
+		if b.index.Dependencies[d.id] != "" {
+			return d.loader
+		}
+	}
+	return ""
+}
+
+func fileFromVersion(v *labrinth.Version) (*File, error) {
+	vf := v.PrimaryFile()
+	if vf == nil {
+		return nil, fmt.Errorf("version %s: no files", v.ID)
+	}
+	if vf.URL == "" {
+		return nil, fmt.Errorf("version %s: %w", v.ID, ErrNoDownloadURL)
+	}
+	if vf.Hashes["sha1"] == "" || vf.Hashes["sha512"] == "" {
+		return nil, fmt.Errorf("version %s: %w", v.ID, ErrMissingHash)
+	}
+
+	return &File{
+		Path: fileDir(v) + vf.Filename,
+		Hashes: map[string]string{
+			"sha1":   vf.Hashes["sha1"],
+			"sha512": vf.Hashes["sha512"],
+		},
+		Downloads: []string{vf.URL},
+		FileSize:  vf.Size,
+	}, nil
+}
+
+// fileDir returns the instance directory for the files of v, judged by its loaders.
+func fileDir(v *labrinth.Version) string {
+	switch {
+	case slices.Contains(v.Loaders, "minecraft"):
+		return "resourcepacks/"
+	case lo.Some(v.Loaders, shaderLoaders):
+		return "shaderpacks/"
+	default:
+		return "mods/"
+	}
+}
+
+// overridePath returns p inside the overrides directory,
+// rejecting paths that would escape the instance.
+func overridePath(p string) (string, error) {
+	clean := path.Clean(strings.ReplaceAll(p, "\\", "/"))
+	if clean == "." || path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("invalid override path %q", p)
+	}
+	return overridesDir + clean, nil
+}
+
+// Write builds the pack and writes it to w as a .mrpack zip.
+func (b *Builder) Write(ctx context.Context, w io.Writer) (*Index, error) {
+	idx, err := b.Build(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	zw := zip.NewWriter(w)
+	iw, err := zw.Create(IndexFileName)
+	if err != nil {
+		return nil, err
+	}
+	enc := json.NewEncoder(iw)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(idx); err != nil {
+		return nil, err
+	}
+
+	for _, o := range b.overrides {
+		p, _ := overridePath(o.path)
+		ow, err := zw.Create(p)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := ow.Write(o.data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
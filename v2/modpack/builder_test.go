@@ -0,0 +1,265 @@
+package modpack
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	labrinth "labrinth/v2"
+	"labrinth/v2/labrinthtest"
+)
+
+// testVersion returns a release of project with one primary file named filename.
+func testVersion(id, project, filename string, loaders ...string) *labrinth.Version {
+	return &labrinth.Version{
+		ID:            id,
+		ProjectID:     project,
+		VersionType:   labrinth.VersionType_Release,
+		DatePublished: labrinth.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Loaders:       loaders,
+		GameVersions:  []string{"1.20.1"},
+		Files: []*labrinth.VersionFile{{
+			Hashes: map[string]string{
+				"sha1":   "sha1-" + id,
+				"sha512": "sha512-" + id,
+			},
+			URL:      "https://cdn.modrinth.com/data/" + project + "/versions/" + id + "/" + filename,
+			Filename: filename,
+			Primary:  true,
+			Size:     int64(len(filename)),
+		}},
+	}
+}
+
+func newTestBuilder(t *testing.T, versions ...*labrinth.Version) (*labrinthtest.Server, *Builder) {
+	t.Helper()
+	srv := labrinthtest.NewServer()
+	t.Cleanup(srv.Close)
+	for _, v := range versions {
+		srv.AddVersion(v)
+	}
+	b := NewBuilder(srv.Client, "Test Pack", "1.0.0").
+		SetDependency(Dependency_Minecraft, "1.20.1").
+		SetDependency(Dependency_FabricLoader, "0.15.11")
+	return srv, b
+}
+
+// readZip returns the contents of the files in the zip data by name.
+func readZip(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{}
+	for _, f := range zr.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name] = b
+	}
+	return files
+}
+
+func TestBuilderWrite(t *testing.T) {
+	srv, b := newTestBuilder(t,
+		testVersion("v1", "sodium", "sodium.jar", "fabric"),
+		testVersion("v2", "faithful", "faithful.zip", "minecraft"),
+		testVersion("v3", "complementary", "complementary.zip", "iris"),
+	)
+	srv.AddProject(&labrinth.Project{ID: "sodium", Slug: "sodium"})
+
+	var buf bytes.Buffer
+	idx, err := b.SetSummary("summary").
+		AddProject("sodium").
+		AddVersion("v2").
+		AddVersion("v3").
+		AddOverride("config/sodium.json", []byte(`{}`)).
+		AddOverride(`options\keys.txt`, []byte("keys")).
+		Write(context.Background(), &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := readZip(t, buf.Bytes())
+	var got Index
+	if err := json.Unmarshal(files[IndexFileName], &got); err != nil {
+		t.Fatalf("%s: %v", IndexFileName, err)
+	}
+	if !reflect.DeepEqual(&got, idx) {
+		t.Errorf("written index = %+v, want %+v", got, idx)
+	}
+
+	want := &Index{
+		FormatVersion: formatVersion,
+		Game:          gameMinecraft,
+		VersionID:     "1.0.0",
+		Name:          "Test Pack",
+		Summary:       "summary",
+		Dependencies: map[string]string{
+			Dependency_Minecraft:    "1.20.1",
+			Dependency_FabricLoader: "0.15.11",
+		},
+		Files: []*File{
+			{
+				Path:      "mods/sodium.jar",
+				Hashes:    map[string]string{"sha1": "sha1-v1", "sha512": "sha512-v1"},
+				Downloads: []string{"https://cdn.modrinth.com/data/sodium/versions/v1/sodium.jar"},
+				FileSize:  int64(len("sodium.jar")),
+			},
+			{
+				Path:      "resourcepacks/faithful.zip",
+				Hashes:    map[string]string{"sha1": "sha1-v2", "sha512": "sha512-v2"},
+				Downloads: []string{"https://cdn.modrinth.com/data/faithful/versions/v2/faithful.zip"},
+				FileSize:  int64(len("faithful.zip")),
+			},
+			{
+				Path:      "shaderpacks/complementary.zip",
+				Hashes:    map[string]string{"sha1": "sha1-v3", "sha512": "sha512-v3"},
+				Downloads: []string{"https://cdn.modrinth.com/data/complementary/versions/v3/complementary.zip"},
+				FileSize:  int64(len("complementary.zip")),
+			},
+		},
+	}
+	if !reflect.DeepEqual(&got, want) {
+		t.Errorf("index = %+v, want %+v", got, want)
+	}
+
+	for name, data := range map[string]string{
+		"overrides/config/sodium.json": `{}`,
+		"overrides/options/keys.txt":   "keys",
+	} {
+		if string(files[name]) != data {
+			t.Errorf("%s = %q, want %q", name, files[name], data)
+		}
+	}
+	if len(files) != 3 {
+		names := make([]string, 0, len(files))
+		for name := range files {
+			names = append(names, name)
+		}
+		t.Errorf("zip has %v, want the index and 2 overrides", names)
+	}
+}
+
+func TestBuilderErrors(t *testing.T) {
+	noURL := testVersion("nourl", "a", "a.jar", "fabric")
+	noURL.Files[0].URL = ""
+	noSHA1 := testVersion("nosha1", "b", "b.jar", "fabric")
+	delete(noSHA1.Files[0].Hashes, "sha1")
+	noSHA512 := testVersion("nosha512", "c", "c.jar", "fabric")
+	delete(noSHA512.Files[0].Hashes, "sha512")
+	noFiles := testVersion("nofiles", "d", "d.jar", "fabric")
+	noFiles.Files = nil
+
+	tests := []struct {
+		name    string
+		build   func(b *Builder)
+		wantErr error
+		wantMsg string
+	}{
+		{"no download url", func(b *Builder) { b.AddVersion("nourl") }, ErrNoDownloadURL, "version nourl"},
+		{"missing sha1", func(b *Builder) { b.AddVersion("nosha1") }, ErrMissingHash, "version nosha1"},
+		{"missing sha512", func(b *Builder) { b.AddVersion("nosha512") }, ErrMissingHash, "version nosha512"},
+		{"no files", func(b *Builder) { b.AddVersion("nofiles") }, nil, "version nofiles: no files"},
+		{"unknown version", func(b *Builder) { b.AddVersion("missing") }, nil, "version missing"},
+		{"unknown project", func(b *Builder) { b.AddProject("missing") }, nil, "project missing"},
+		{"escaping override", func(b *Builder) { b.AddOverride("../evil", nil) }, nil, `invalid override path "../evil"`},
+		{"absolute override", func(b *Builder) { b.AddOverride("/evil", nil) }, nil, `invalid override path "/evil"`},
+		{"no minecraft", func(b *Builder) { b.SetDependency(Dependency_Minecraft, "") }, nil, "minecraft dependency is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, b := newTestBuilder(t, noURL, noSHA1, noSHA512, noFiles)
+			tt.build(b)
+
+			var buf bytes.Buffer
+			idx, err := b.Write(context.Background(), &buf)
+			if err == nil {
+				t.Fatalf("Write = %+v, want error", idx)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Write: %v, want %v", err, tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Errorf("Write: %v, want it to contain %q", err, tt.wantMsg)
+			}
+			if buf.Len() != 0 {
+				t.Errorf("Write wrote %d bytes on error", buf.Len())
+			}
+		})
+	}
+}
+
+func TestBuilderJoinsErrors(t *testing.T) {
+	noURL := testVersion("nourl", "a", "a.jar", "fabric")
+	noURL.Files[0].URL = ""
+	noSHA1 := testVersion("nosha1", "b", "b.jar", "fabric")
+	delete(noSHA1.Files[0].Hashes, "sha1")
+
+	_, b := newTestBuilder(t, noURL, noSHA1)
+	_, err := b.AddVersion("nourl").AddVersion("nosha1").Build(context.Background())
+	if !errors.Is(err, ErrNoDownloadURL) || !errors.Is(err, ErrMissingHash) {
+		t.Errorf("Build: %v, want both %v and %v", err, ErrNoDownloadURL, ErrMissingHash)
+	}
+}
+
+func TestBuilderDuplicates(t *testing.T) {
+	srv, b := newTestBuilder(t,
+		testVersion("v1", "sodium", "sodium.jar", "fabric"),
+		testVersion("v2", "sodium-fork", "sodium.jar", "fabric"),
+	)
+	srv.AddProject(&labrinth.Project{ID: "sodium", Slug: "sodium"})
+
+	idx, err := b.AddProject("sodium").AddVersion("v1").Build(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(idx.Files) != 1 {
+		t.Errorf("Files = %d, want the same version once", len(idx.Files))
+	}
+
+	_, err = b.AddVersion("v2").Build(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "duplicated path mods/sodium.jar") {
+		t.Errorf("Build: %v, want duplicated path", err)
+	}
+}
+
+func TestBuilderAddProjectFiltersByLoader(t *testing.T) {
+	forge := testVersion("forge", "sodium", "sodium-forge.jar", "forge")
+	forge.DatePublished.Time = forge.DatePublished.Add(time.Hour)
+	srv, b := newTestBuilder(t, testVersion("fabric", "sodium", "sodium-fabric.jar", "fabric"), forge)
+	srv.AddProject(&labrinth.Project{ID: "sodium", Slug: "sodium"})
+
+	var gameVersions string
+	srv.HandleFunc(http.MethodGet, "project/sodium/version", func(w http.ResponseWriter, r *http.Request) {
+		gameVersions = r.URL.Query().Get("game_versions")
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]*labrinth.Version{testVersion("fabric", "sodium", "sodium-fabric.jar", "fabric"), forge})
+	})
+
+	idx, err := b.AddProject("sodium").Build(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(idx.Files) != 1 || idx.Files[0].Path != "mods/sodium-fabric.jar" {
+		t.Errorf("Files = %+v, want the fabric release", idx.Files)
+	}
+	if gameVersions != `["1.20.1"]` {
+		t.Errorf("game_versions = %s, want [\"1.20.1\"]", gameVersions)
+	}
+}
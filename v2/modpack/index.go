@@ -0,0 +1,66 @@
+// Package modpack reads and writes Modrinth modpacks (.mrpack).
+package modpack
+
+// IndexFileName is the path of the index inside a .mrpack.
+const IndexFileName = "modrinth.index.json"
+
+// Directory inside a .mrpack whose contents are copied into the instance.
+const overridesDir = "overrides/"
+
+const (
+	formatVersion = 1
+	gameMinecraft = "minecraft"
+)
+
+// Dependency IDs for Index.Dependencies.
+const (
+	Dependency_Minecraft    = "minecraft"
+	Dependency_Forge        = "forge"
+	Dependency_NeoForge     = "neoforge"
+	Dependency_FabricLoader = "fabric-loader"
+	Dependency_QuiltLoader  = "quilt-loader"
+)
+
+// Index is the modrinth.index.json of a modpack.
+type Index struct {
+	FormatVersion int     `json:"formatVersion"`
+	Game          string  `json:"game"`
+	VersionID     string  `json:"versionId"`
+	Name          string  `json:"name"`
+	Summary       string  `json:"summary,omitempty"`
+	Files         []*File `json:"files"`
+	// Game and loader versions by dependency ID, e.g. "minecraft": "1.20.1".
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+type File struct {
+	// Path relative to the instance directory, e.g. "mods/sodium.jar".
+	Path string `json:"path"`
+	// Must contain "sha1" and "sha512".
+	Hashes    map[string]string `json:"hashes"`
+	Env       *Env              `json:"env,omitempty"`
+	Downloads []string          `json:"downloads"`
+	FileSize  int64             `json:"fileSize"`
+}
+
+type Env struct {
+	Client EnvSupport `json:"client"`
+	Server EnvSupport `json:"server"`
+}
+
+type EnvSupport string
+
+const (
+	EnvSupport_Required    = EnvSupport("required")
+	EnvSupport_Optional    = EnvSupport("optional")
+	EnvSupport_Unsupported = EnvSupport("unsupported")
+)
+
+// TotalSize returns the sum of the file sizes, excluding overrides.
+func (idx *Index) TotalSize() int64 {
+	var n int64
+	for _, f := range idx.Files {
+		n += f.FileSize
+	}
+	return n
+}
@@ -0,0 +1,70 @@
+package modpack
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	labrinth "labrinth/v2"
+
+	"github.com/samber/lo"
+)
+
+// Parse reads the index of the .mrpack read from r.
+// The whole pack is buffered, since a zip is read from its end.
+func Parse(r io.Reader) (*Index, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := zr.Open(IndexFileName)
+	if err != nil {
+		return nil, fmt.Errorf("no %s in modpack: %w", IndexFileName, err)
+	}
+	defer f.Close()
+
+	var idx = new(Index)
+	if err := json.NewDecoder(f).Decode(idx); err != nil {
+		return nil, err
+	}
+	if idx.FormatVersion != formatVersion {
+		return nil, fmt.Errorf("unsupported modpack format version %d", idx.FormatVersion)
+	}
+	return idx, nil
+}
+
+// ResolvePack looks up the version of each file of idx by its sha512 hash,
+// returning them keyed by File.Path.
+// Files not hosted on Modrinth are left out rather than failing.
+func ResolvePack(ctx context.Context, c *labrinth.Client, idx *Index) (map[string]*labrinth.Version, error) {
+	files := lo.Filter(idx.Files, func(f *File, _ int) bool {
+		return f.Hashes["sha512"] != ""
+	})
+	if len(files) == 0 {
+		return map[string]*labrinth.Version{}, nil
+	}
+
+	hashes := lo.Map(files, func(f *File, _ int) string {
+		return f.Hashes["sha512"]
+	})
+	versions, _, err := c.VersionFiles.GetFromHashes(ctx, hashes, labrinth.HashAlgorithm_SHA512)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := map[string]*labrinth.Version{}
+	for _, f := range files {
+		if v, ok := versions[f.Hashes["sha512"]]; ok {
+			resolved[f.Path] = v
+		}
+	}
+	return resolved, nil
+}
@@ -0,0 +1,159 @@
+package modpack
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	labrinth "labrinth/v2"
+)
+
+func TestParseRoundTrip(t *testing.T) {
+	srv, b := newTestBuilder(t,
+		testVersion("v1", "sodium", "sodium.jar", "fabric"),
+		testVersion("v2", "faithful", "faithful.zip", "minecraft"),
+	)
+	srv.AddProject(&labrinth.Project{ID: "sodium", Slug: "sodium"})
+
+	var buf bytes.Buffer
+	want, err := b.SetSummary("summary").
+		AddProject("sodium").
+		AddVersion("v2").
+		AddOverride("config/sodium.json", []byte(`{}`)).
+		Write(context.Background(), &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Parse(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse = %+v, want %+v", got, want)
+	}
+}
+
+// zipOf returns a zip holding files by name.
+func zipOf(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, data := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestParseErrors(t *testing.T) {
+	valid := zipOf(t, map[string]string{IndexFileName: `{"formatVersion":1,"game":"minecraft","files":[]}`})
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"empty", nil, "zip"},
+		{"not a zip", []byte("not a zip"), "zip"},
+		{"truncated zip", valid[:len(valid)/2], "zip"},
+		{"no index", zipOf(t, map[string]string{"overrides/a.txt": "a"}), "no " + IndexFileName},
+		{"invalid json", zipOf(t, map[string]string{IndexFileName: `{"formatVersion":1,`}), "unexpected EOF"},
+		{"wrong type", zipOf(t, map[string]string{IndexFileName: `{"formatVersion":1,"files":{}}`}), "cannot unmarshal"},
+		{"unsupported format", zipOf(t, map[string]string{IndexFileName: `{"formatVersion":2,"files":[]}`}), "unsupported modpack format version 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idx, err := Parse(bytes.NewReader(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Parse: %v, want error containing %q", err, tt.want)
+			}
+			if idx != nil {
+				t.Errorf("Parse = %+v, want nil on error", idx)
+			}
+		})
+	}
+}
+
+func TestResolvePack(t *testing.T) {
+	srv, _ := newTestBuilder(t)
+	known := map[string]*labrinth.Version{
+		"sha512-v1": testVersion("v1", "sodium", "sodium.jar", "fabric"),
+	}
+	var requested []string
+	srv.HandleFunc(http.MethodPost, "version_files", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Hashes    []string `json:"hashes"`
+			Algorithm string   `json:"algorithm"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Algorithm != "sha512" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		requested = body.Hashes
+		// Like the API, hashes of unknown files are left out.
+		versions := map[string]*labrinth.Version{}
+		for _, h := range body.Hashes {
+			if v, ok := known[h]; ok {
+				versions[h] = v
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(versions)
+	})
+
+	idx := &Index{Files: []*File{
+		{Path: "mods/sodium.jar", Hashes: map[string]string{"sha1": "sha1-v1", "sha512": "sha512-v1"}},
+		{Path: "mods/private.jar", Hashes: map[string]string{"sha1": "sha1-x", "sha512": "sha512-unknown"}},
+		{Path: "mods/sha1-only.jar", Hashes: map[string]string{"sha1": "sha1-y"}},
+	}}
+	got, err := ResolvePack(context.Background(), srv.Client, idx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got["mods/sodium.jar"] == nil || got["mods/sodium.jar"].ID != "v1" {
+		t.Errorf("ResolvePack = %v, want only mods/sodium.jar resolved to v1", got)
+	}
+	if want := []string{"sha512-v1", "sha512-unknown"}; !reflect.DeepEqual(requested, want) {
+		t.Errorf("requested hashes = %v, want %v", requested, want)
+	}
+}
+
+func TestResolvePackNoHashes(t *testing.T) {
+	srv, _ := newTestBuilder(t)
+	srv.HandleFunc(http.MethodPost, "version_files", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request with no sha512 hashes")
+	})
+
+	got, err := ResolvePack(context.Background(), srv.Client, &Index{Files: []*File{
+		{Path: "mods/a.jar", Hashes: map[string]string{"sha1": "a"}},
+	}})
+	if err != nil || got == nil || len(got) != 0 {
+		t.Errorf("ResolvePack = %v, %v, want an empty map", got, err)
+	}
+}
+
+func TestResolvePackError(t *testing.T) {
+	srv, _ := newTestBuilder(t)
+	srv.Handle(http.MethodPost, "version_files", http.StatusBadRequest, &labrinth.ErrorResponse{Code: "invalid_input"})
+
+	got, err := ResolvePack(context.Background(), srv.Client, &Index{Files: []*File{
+		{Path: "mods/a.jar", Hashes: map[string]string{"sha512": "a"}},
+	}})
+	if err == nil || got != nil {
+		t.Errorf("ResolvePack = %v, %v, want an error and no versions", got, err)
+	}
+}
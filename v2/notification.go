@@ -0,0 +1,136 @@
+package labrinth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type NotificationsService service
+
+func (s *NotificationsService) List(ctx context.Context, userIDUsername string) ([]*Notification, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("user/%s/notifications", userIDUsername), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var notifications = []*Notification{}
+	res, err := s.client.Do(ctx, req, &notifications)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return notifications, res, nil
+}
+
+type PollOptions struct {
+	// Interval between polls.
+	// Default: 30s
+	Interval time.Duration
+	// Keep polling after a failed poll, waiting with exponential backoff.
+	// Otherwise the channel is closed at the first error.
+	Reconnect bool
+	// Upper bound of the backoff.
+	// Default: 5m
+	MaxBackoff time.Duration
+}
+
+const (
+	defaultPollInterval   = 30 * time.Second
+	defaultPollMaxBackoff = 5 * time.Minute
+)
+
+// Poll lists the authorized user's notifications every opts.Interval, 30s by default,
+// and sends each new one once. The public API has no push channel, so this is not
+// a live stream: a notification can take up to the interval to be sent.
+// Unread notifications are sent first.
+//
+// The channel is closed when ctx is done, or after an event with Err
+// unless opts.Reconnect is set.
+func (s *NotificationsService) Poll(ctx context.Context, opts *PollOptions) (<-chan *NotificationEvent, error) {
+	o := PollOptions{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Interval <= 0 {
+		o.Interval = defaultPollInterval
+	}
+	if o.MaxBackoff <= 0 {
+		o.MaxBackoff = defaultPollMaxBackoff
+	}
+
+	user, _, err := s.client.Users.GetCurrent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *NotificationEvent)
+	go s.poll(ctx, user.ID, o, ch)
+	return ch, nil
+}
+
+func (s *NotificationsService) poll(ctx context.Context, userID string, o PollOptions, ch chan<- *NotificationEvent) {
+	defer close(ch)
+
+	send := func(ev *NotificationEvent) bool {
+		select {
+		case ch <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	// Creation times of the notifications already seen. IDs older than cutoff,
+	// the oldest notification listed so far, are forgotten to bound the set,
+	// and notifications older than it are never sent, so that one dropping off
+	// the list and coming back is not sent again.
+	seen := map[string]time.Time{}
+	var cutoff time.Time
+	first := true
+	backoff := o.Interval
+	for {
+		wait := o.Interval
+		notifications, _, err := s.List(ctx, userID)
+		switch {
+		case err != nil:
+			if ctx.Err() != nil || !send(&NotificationEvent{Err: err}) || !o.Reconnect {
+				return
+			}
+			backoff = min(backoff*2, o.MaxBackoff)
+			wait = backoff
+		default:
+			backoff = o.Interval
+			var oldest time.Time
+			for _, n := range notifications {
+				if oldest.IsZero() || n.Created.Before(oldest) {
+					oldest = n.Created.Time
+				}
+				if _, ok := seen[n.ID]; ok {
+					continue
+				}
+				seen[n.ID] = n.Created.Time
+				if n.Created.Before(cutoff) || first && n.Read {
+					continue
+				}
+				if !send(&NotificationEvent{Notification: n}) {
+					return
+				}
+			}
+			if oldest.After(cutoff) {
+				cutoff = oldest
+			}
+			for id, created := range seen {
+				if created.Before(cutoff) {
+					delete(seen, id)
+				}
+			}
+			first = false
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return
+		}
+	}
+}
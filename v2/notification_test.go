@@ -0,0 +1,150 @@
+package labrinth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// notificationServer serves the authorized user "me" and the scripted
+// notification lists in order, repeating the last one.
+type notificationServer struct {
+	mu    sync.Mutex
+	lists []func(w http.ResponseWriter)
+	times []time.Time
+}
+
+func (s *notificationServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/v2/user" {
+		w.Write([]byte(`{"id":"me"}`))
+		return
+	}
+	s.mu.Lock()
+	s.times = append(s.times, time.Now())
+	next := s.lists[0]
+	if len(s.lists) > 1 {
+		s.lists = s.lists[1:]
+	}
+	s.mu.Unlock()
+	next(w)
+}
+
+func listOf(ns ...*Notification) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		json.NewEncoder(w).Encode(ns)
+	}
+}
+
+func failing(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusInternalServerError)
+}
+
+func receive(t *testing.T, ch <-chan *NotificationEvent, n int) []*NotificationEvent {
+	t.Helper()
+	var evs []*NotificationEvent
+	timeout := time.After(5 * time.Second)
+	for len(evs) < n {
+		select {
+		case ev, ok := <-ch:
+			if !ok {
+				return evs
+			}
+			evs = append(evs, ev)
+		case <-timeout:
+			t.Fatalf("got %d events, want %d", len(evs), n)
+		}
+	}
+	return evs
+}
+
+func TestPollDedup(t *testing.T) {
+	at := func(min int) Time { return Time{time.Date(2024, 1, 1, 0, min, 0, 0, time.UTC)} }
+	na := &Notification{ID: "a", Read: true, Created: at(1)}
+	nb := &Notification{ID: "b", Created: at(2)}
+	nc := &Notification{ID: "c", Created: at(3)}
+	nd := &Notification{ID: "d", Created: at(4)}
+	srv := &notificationServer{lists: []func(http.ResponseWriter){
+		listOf(na, nb),
+		listOf(na, nb, nc),
+		// a drops off the list and comes back, and must not be sent.
+		listOf(nb, nc),
+		listOf(na, nb, nc, nd),
+	}}
+	c := newTestClient(t, srv.ServeHTTP)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := c.Notifications.Poll(ctx, &PollOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for _, ev := range receive(t, ch, 3) {
+		if ev.Err != nil {
+			t.Fatalf("Err: %v", ev.Err)
+		}
+		ids = append(ids, ev.Notification.ID)
+	}
+	// Wait for a few more polls of the last list, which must send nothing.
+	select {
+	case ev := <-ch:
+		t.Errorf("unexpected event %+v", ev)
+	case <-time.After(20 * time.Millisecond):
+	}
+	if want := "[b c d]"; fmt.Sprint(ids) != want {
+		t.Errorf("sent %v, want %s", ids, want)
+	}
+}
+
+func TestPollBackoff(t *testing.T) {
+	srv := &notificationServer{lists: []func(http.ResponseWriter){
+		failing, failing, failing,
+		listOf(&Notification{ID: "x"}),
+	}}
+	c := newTestClient(t, srv.ServeHTTP)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	const interval = 5 * time.Millisecond
+	ch, err := c.Notifications.Poll(ctx, &PollOptions{Interval: interval, Reconnect: true, MaxBackoff: 4 * interval})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	evs := receive(t, ch, 4)
+	for i, ev := range evs[:3] {
+		if ev.Err == nil {
+			t.Errorf("event %d = %+v, want an error", i, ev.Notification)
+		}
+	}
+	if evs[3].Notification == nil || evs[3].Notification.ID != "x" {
+		t.Fatalf("event 3 = %+v, want notification x", evs[3])
+	}
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	for i, want := range []time.Duration{2 * interval, 4 * interval, 4 * interval} {
+		if gap := srv.times[i+1].Sub(srv.times[i]); gap < want {
+			t.Errorf("wait after failure %d = %v, want at least %v", i+1, gap, want)
+		}
+	}
+}
+
+func TestPollStopsWithoutReconnect(t *testing.T) {
+	srv := &notificationServer{lists: []func(http.ResponseWriter){failing}}
+	c := newTestClient(t, srv.ServeHTTP)
+
+	ch, err := c.Notifications.Poll(context.Background(), &PollOptions{Interval: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	evs := receive(t, ch, 2)
+	if len(evs) != 1 || evs[0].Err == nil {
+		t.Errorf("got %d events, want one error before the channel closes", len(evs))
+	}
+}
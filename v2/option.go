@@ -0,0 +1,119 @@
+package labrinth
+
+import (
+	"fmt"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+)
+
+// Option configures a Client in NewClient.
+type Option func(c *Client)
+
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.hc = hc
+		c.buildTransport()
+	}
+}
+
+// WithTimeout sets the timeout of the whole request including reading the body.
+// It applies in addition to the deadline of the context passed to each call,
+// so whichever expires first cancels the request. Zero means no timeout.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		if c.hc == nil {
+			c.hc = createClient()
+		}
+		// Copy so that a client given by WithHTTPClient is not modified.
+		hc := *c.hc
+		hc.Timeout = d
+		c.hc = &hc
+		c.buildTransport()
+	}
+}
+
+// WithToken sets the token sent in the Authorization header.
+// Surrounding whitespace and a "Bearer " prefix are removed,
+// since the API expects the raw token.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.authMu.Lock()
+		defer c.authMu.Unlock()
+		c.AuthToken = normalizeToken(token)
+		c.auth = nil
+		if c.AuthToken != "" {
+			c.auth = PATAuth(c.AuthToken)
+		}
+	}
+}
+
+func normalizeToken(token string) string {
+	token = strings.TrimSpace(token)
+	if len(token) > len("Bearer ") && strings.EqualFold(token[:len("Bearer ")], "Bearer ") {
+		token = strings.TrimSpace(token[len("Bearer "):])
+	}
+	return token
+}
+
+// WithBaseURL sets the API base, e.g. "https://staging-api.modrinth.com/v2".
+// An invalid URL is ignored; use SetBaseURLE to get the error.
+func WithBaseURL(url string) Option {
+	return func(c *Client) {
+		if u, err := parseBaseURL(url); err == nil {
+			c.BaseURL = u
+		}
+	}
+}
+
+// parseBaseURL parses an absolute URL and makes its path end with "/",
+// so that relative paths like "project/x" resolve under it.
+func parseBaseURL(url string) (*neturl.URL, error) {
+	u, err := neturl.Parse(url)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("base url must be absolute: %q", url)
+	}
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	return u, nil
+}
+
+func WithUserAgent(ua string) Option {
+	return func(c *Client) {
+		c.UserAgent = ua
+	}
+}
+
+// WithHeader sets a header sent with every request built by the client.
+// Per-request options are applied afterwards and can override it.
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		if c.headers == nil {
+			c.headers = http.Header{}
+		}
+		c.headers.Set(key, value)
+	}
+}
+
+func WithJSONMarshaler(marshaler JSONMarshaler) Option {
+	return func(c *Client) {
+		c.JSONMarshaler = marshaler
+	}
+}
+
+func WithJSONUnmarshaler(unmarshaler JSONUnmarshaler) Option {
+	return func(c *Client) {
+		c.JSONUnmarshaler = unmarshaler
+	}
+}
+
+func WithRetry(p *RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = p
+	}
+}
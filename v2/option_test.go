@@ -0,0 +1,78 @@
+package labrinth
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestParseBaseURL(t *testing.T) {
+	for _, base := range []string{"https://api.modrinth.com/v2", "https://api.modrinth.com/v2/"} {
+		u, err := parseBaseURL(base)
+		if err != nil {
+			t.Fatalf("parseBaseURL(%q): %v", base, err)
+		}
+		for path, want := range map[string]string{
+			"search":    "https://api.modrinth.com/v2/search",
+			"project/x": "https://api.modrinth.com/v2/project/x",
+		} {
+			if got, _ := u.Parse(path); got.String() != want {
+				t.Errorf("%q + %q = %s, want %s", base, path, got, want)
+			}
+		}
+	}
+}
+
+func TestParseBaseURLRelative(t *testing.T) {
+	if _, err := parseBaseURL("/v2"); err == nil {
+		t.Error("parseBaseURL(/v2): want error")
+	}
+}
+
+func TestWithHeader(t *testing.T) {
+	c := NewClient(WithHeader("CF-Access-Client-Id", "id"), WithHeader("X-Trace", "1"), WithToken("mrp_token"))
+	req, err := c.NewRequest(http.MethodGet, "project/x", nil, func(req *http.Request) {
+		req.Header.Set("X-Trace", "2")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := req.Header.Get("CF-Access-Client-Id"); got != "id" {
+		t.Errorf("CF-Access-Client-Id = %q, want id", got)
+	}
+	if got := req.Header.Get("X-Trace"); got != "2" {
+		t.Errorf("X-Trace = %q, want the request option's 2", got)
+	}
+
+	// Headers are copied, so a request option cannot change the client's defaults.
+	req.Header.Add("CF-Access-Client-Id", "other")
+	if got := c.headers.Values("CF-Access-Client-Id"); len(got) != 1 {
+		t.Errorf("client headers changed to %q", got)
+	}
+}
+
+func TestLoggerRedactsHeaders(t *testing.T) {
+	var logged *http.Request
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {},
+		WithToken("mrp_token"), WithHeader("CF-Access-Client-Secret", "s3cret"), WithHeader("X-Trace", "1"))
+	c.SetLogger(func(req *http.Request, res *http.Response, err error, dur time.Duration) {
+		logged = req
+	})
+	req, _ := c.NewRequest(http.MethodGet, "project/x", nil)
+	if _, err := c.Do(context.Background(), req, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, k := range []string{"Authorization", "CF-Access-Client-Secret"} {
+		if got := logged.Header.Get(k); got != redacted {
+			t.Errorf("logged %s = %q, want %s", k, got, redacted)
+		}
+	}
+	if got := logged.Header.Get("X-Trace"); got != "1" {
+		t.Errorf("logged X-Trace = %q, want 1", got)
+	}
+	if got := req.Header.Get("CF-Access-Client-Secret"); got != "s3cret" {
+		t.Errorf("caller's header = %q after logging", got)
+	}
+}
@@ -0,0 +1,143 @@
+package labrinth
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/google/go-querystring/query"
+)
+
+// OrganizationsService handles organizations, which only exist in the v3 API.
+// Paths are resolved relative to the v2 base, so a custom host keeps working.
+type OrganizationsService service
+
+const organizationPath = "../v3/organization"
+
+func (s *OrganizationsService) Get(ctx context.Context, idSlug string) (*Organization, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", organizationPath, idSlug), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var org = new(Organization)
+	res, err := s.client.Do(ctx, req, org)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return org, res, nil
+}
+
+// GetProjects returns the projects owned by an organization.
+// They are in the v3 format, so fields only present in v2 are left empty.
+func (s *OrganizationsService) GetProjects(ctx context.Context, idSlug string) ([]*Project, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s/projects", organizationPath, idSlug), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var projs = []*Project{}
+	res, err := s.client.Do(ctx, req, &projs)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return projs, res, nil
+}
+
+type CreateOrganizationParams struct {
+	Slug        string `json:"slug"`        // Required
+	Name        string `json:"name"`        // Required
+	Description string `json:"description"` // Required
+}
+
+func (s *OrganizationsService) Create(ctx context.Context, params *CreateOrganizationParams) (*Organization, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, organizationPath, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var org = new(Organization)
+	res, err := s.client.Do(ctx, req, org)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return org, res, nil
+}
+
+type EditOrganizationParams struct {
+	Slug        string `json:"slug,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func (s *OrganizationsService) Edit(ctx context.Context, idSlug string, params *EditOrganizationParams) (*Response, error) {
+	req, err := s.client.NewRequest(http.MethodPatch, fmt.Sprintf("%s/%s", organizationPath, idSlug), params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *OrganizationsService) Delete(ctx context.Context, idSlug string) (*Response, error) {
+	req, err := s.client.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%s", organizationPath, idSlug), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// AddMember invites a user to the team of an organization.
+func (s *OrganizationsService) AddMember(ctx context.Context, org *Organization, userID string) (*Response, error) {
+	return s.client.Teams.AddMember(ctx, org.TeamID, userID)
+}
+
+type EditOrganizationIconParams struct {
+	// Extension of icon file to upload.
+	// Example: "jpeg"
+	Ext  string    `url:"ext"`
+	File io.Reader `url:"-"`
+}
+
+func (s *OrganizationsService) ChangeIcon(ctx context.Context, idSlug string, params *EditOrganizationIconParams) (*Response, error) {
+	if err := checkImageExt(params.Ext); err != nil {
+		return nil, err
+	}
+	if params.Ext == "jpg" {
+		params.Ext = "jpeg"
+	}
+	file, err := sniffImage(params.Ext, params.File)
+	if err != nil {
+		return nil, err
+	}
+	q, err := query.Values(params)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewUploadRequest(
+		http.MethodPatch,
+		fmt.Sprintf("%s/%s/icon?%s", organizationPath, idSlug, q.Encode()),
+		"image/"+params.Ext,
+		file)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *OrganizationsService) DeleteIcon(ctx context.Context, idSlug string) (*Response, error) {
+	req, err := s.client.NewRequest(http.MethodDelete, fmt.Sprintf("%s/%s/icon", organizationPath, idSlug), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
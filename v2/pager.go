@@ -0,0 +1,90 @@
+package labrinth
+
+import (
+	"context"
+	"net/http"
+	neturl "net/url"
+	"strconv"
+)
+
+// Pager iterates page by page over a list endpoint taking offset and limit,
+// in the same way as SearchPager. See ProjectsService.GetVersionsAll.
+type Pager[T any] struct {
+	c      *Client
+	base   string
+	q      neturl.Values
+	offset int
+	limit  int
+	page   []T
+	res    *Response
+	err    error
+	done   bool
+}
+
+// doPaginated returns a pager requesting base with q, advancing offset by limit
+// until a page comes back short or empty. A non-positive limit defaults to 10.
+func doPaginated[T any](c *Client, base string, q neturl.Values, limit int) *Pager[T] {
+	if limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	p := &Pager[T]{c: c, base: base, q: neturl.Values{}, limit: limit}
+	for k, vs := range q {
+		p.q[k] = append([]string(nil), vs...)
+	}
+	return p
+}
+
+// Next fetches the next page. It returns false when there are no more results,
+// an error occurred, or ctx is done.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	if p.done {
+		return false
+	}
+	if err := ctx.Err(); err != nil {
+		p.err = err
+		p.done = true
+		return false
+	}
+
+	p.q.Set("offset", strconv.Itoa(p.offset))
+	p.q.Set("limit", strconv.Itoa(p.limit))
+	req, err := p.c.NewRequest(http.MethodGet, p.base+"?"+p.q.Encode(), nil)
+	if err != nil {
+		p.err = err
+		p.done = true
+		return false
+	}
+
+	var page = []T{}
+	res, err := p.c.Do(ctx, req, &page)
+	p.res = res
+	if err != nil {
+		p.err = err
+		p.done = true
+		return false
+	}
+	if len(page) == 0 {
+		p.done = true
+		return false
+	}
+
+	p.page = page
+	p.offset += len(page)
+	p.done = len(page) < p.limit
+	return true
+}
+
+// Page returns the items of the current page.
+func (p *Pager[T]) Page() []T {
+	return p.page
+}
+
+// Response returns the response of the last request.
+func (p *Pager[T]) Response() *Response {
+	return p.res
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
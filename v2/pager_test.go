@@ -0,0 +1,85 @@
+package labrinth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestGetVersionsAll(t *testing.T) {
+	const total = 25
+	var queries []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/project/sodium/version" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		queries = append(queries, q.Encode())
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		page := []*Version{}
+		for i := offset; i < min(offset+limit, total); i++ {
+			page = append(page, &Version{ID: fmt.Sprint(i)})
+		}
+		json.NewEncoder(w).Encode(page)
+	})
+
+	p := c.Projects.GetVersionsAll("sodium", &ListProjectVersionsParams{Loaders: []string{"fabric"}}, 10)
+	var ids []string
+	for p.Next(context.Background()) {
+		for _, v := range p.Page() {
+			ids = append(ids, v.ID)
+		}
+	}
+	if err := p.Err(); err != nil {
+		t.Fatalf("Err: %v", err)
+	}
+	if len(ids) != total || ids[0] != "0" || ids[total-1] != "24" {
+		t.Errorf("got %d versions %v, want 0..24", len(ids), ids)
+	}
+	want := []string{
+		`limit=10&loaders=%5B%22fabric%22%5D&offset=0`,
+		`limit=10&loaders=%5B%22fabric%22%5D&offset=10`,
+		`limit=10&loaders=%5B%22fabric%22%5D&offset=20`,
+	}
+	if fmt.Sprint(queries) != fmt.Sprint(want) {
+		t.Errorf("queries = %q, want %q", queries, want)
+	}
+}
+
+func TestPagerStopsOnEmptyPage(t *testing.T) {
+	requests := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Write([]byte(`[{"id":"a"},{"id":"b"}]`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	})
+
+	p := c.Projects.GetVersionsAll("sodium", nil, 2)
+	pages := 0
+	for p.Next(context.Background()) {
+		pages++
+	}
+	if pages != 1 || requests != 2 || p.Err() != nil {
+		t.Errorf("got %d pages in %d requests, err %v, want 1 page in 2 requests", pages, requests, p.Err())
+	}
+}
+
+func TestPagerError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	p := c.Projects.GetVersionsAll("missing", nil, 0)
+	if p.Next(context.Background()) {
+		t.Error("Next = true on 404")
+	}
+	if p.Response().StatusCode != http.StatusNotFound || p.Err() == nil {
+		t.Errorf("Response %d, Err %v, want 404 and an error", p.Response().StatusCode, p.Err())
+	}
+}
@@ -3,6 +3,7 @@ package labrinth
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -10,10 +11,16 @@ import (
 	"net/http"
 	"net/textproto"
 	neturl "net/url"
+	"os"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	"labrinth/facets"
+
 	"github.com/google/go-querystring/query"
+	"github.com/samber/lo"
 )
 
 type ProjectsService service
@@ -35,6 +42,17 @@ type SearchParams struct {
 	Limit int `url:"limit,omitempty"`
 }
 
+// NewSearchParams returns search params with the default limit of 10.
+func NewSearchParams() *SearchParams {
+	return &SearchParams{Limit: defaultSearchLimit}
+}
+
+// SetFacets sets Facets to the string form of b and returns p for chaining.
+func (p *SearchParams) SetFacets(b facets.FacetsBuilder) *SearchParams {
+	p.Facets = b.String()
+	return p
+}
+
 type SearchIndex string
 
 const (
@@ -85,8 +103,12 @@ func (s *ProjectsService) Get(ctx context.Context, idSlug string) (*Project, *Re
 }
 
 func (s *ProjectsService) GetAll(ctx context.Context, idSlugs []string) ([]*Project, *Response, error) {
+	ids, err := s.client.queryArray(idSlugs)
+	if err != nil {
+		return nil, nil, err
+	}
 	q := neturl.Values{}
-	q.Add("ids", queryArray(idSlugs))
+	q.Add("ids", ids)
 
 	req, err := s.client.NewRequest(http.MethodGet, "projects?"+q.Encode(), nil)
 	if err != nil {
@@ -94,19 +116,54 @@ func (s *ProjectsService) GetAll(ctx context.Context, idSlugs []string) ([]*Proj
 	}
 
 	var projs = []*Project{}
-	res, err := s.client.Do(ctx, req, projs)
+	res, err := s.client.Do(ctx, req, &projs)
 	if err != nil {
-		return nil, nil, err
+		return nil, res, err
 	}
 	return projs, res, nil
 }
 
+// GetFromHit fetches the full project of a search hit.
+func (s *ProjectsService) GetFromHit(ctx context.Context, hit *SearchHit) (*Project, *Response, error) {
+	return s.Get(ctx, hit.ID())
+}
+
+// GetFromHits fetches the full projects of search hits at once.
+func (s *ProjectsService) GetFromHits(ctx context.Context, hits []*SearchHit) ([]*Project, *Response, error) {
+	ids := lo.Map(hits, func(hit *SearchHit, _ int) string {
+		return hit.ID()
+	})
+	return s.GetAll(ctx, ids)
+}
+
+// GetOrganization fetches the organization owning proj.
+// It returns nil, nil, nil without a request when the project has no organization.
+func (s *ProjectsService) GetOrganization(ctx context.Context, proj *Project) (*Organization, *Response, error) {
+	if proj.Organization == nil || *proj.Organization == "" {
+		return nil, nil, nil
+	}
+	return s.client.Organizations.Get(ctx, *proj.Organization)
+}
+
+const maxRandomCount = 100
+
+// GetRandomE is like GetRandom but returns an error
+// instead of clamping count when it is out of [1, 100].
+func (s *ProjectsService) GetRandomE(ctx context.Context, count int) ([]*Project, *Response, error) {
+	if count < 1 || count > maxRandomCount {
+		return nil, nil, fmt.Errorf("count must be between 1 and %d, got %d", maxRandomCount, count)
+	}
+	return s.GetRandom(ctx, count)
+}
+
+// GetRandom returns count random projects.
+// count is clamped to [0, 100]; see GetRandomE to reject it instead.
 func (s *ProjectsService) GetRandom(ctx context.Context, count int) ([]*Project, *Response, error) {
 	if count < 0 {
 		count = 0
 	}
-	if count > 100 {
-		count = 100
+	if count > maxRandomCount {
+		count = maxRandomCount
 	}
 
 	q := neturl.Values{}
@@ -118,9 +175,9 @@ func (s *ProjectsService) GetRandom(ctx context.Context, count int) ([]*Project,
 	}
 
 	var projs = []*Project{}
-	res, err := s.client.Do(ctx, req, projs)
+	res, err := s.client.Do(ctx, req, &projs)
 	if err != nil {
-		return nil, nil, err
+		return nil, res, err
 	}
 	return projs, res, nil
 }
@@ -164,7 +221,63 @@ type creatableProject struct {
 	LicenseURL           string                `json:"license_url,omitempty"`
 }
 
+var (
+	creatableProjectTypes = []ProjectType{
+		ProjectType_Mod,
+		ProjectType_Modpack,
+		ProjectType_Resourcepack,
+		ProjectType_Shader,
+	}
+	creatableSideSupports = []ProjectSideSupport{
+		ProjectSideSupport_Required,
+		ProjectSideSupport_Optional,
+		ProjectSideSupport_Unsupported,
+	}
+)
+
+// validate reports every missing required field and unknown enum value at once.
+func (p *creatableProject) validate() error {
+	var errs []error
+	required := []struct {
+		name  string
+		value string
+	}{
+		{"slug", p.Slug},
+		{"title", p.Title},
+		{"description", p.Description},
+		{"project_type", string(p.ProjectType)},
+		{"client_side", string(p.ClientSide)},
+		{"server_side", string(p.ServerSide)},
+		{"body", p.Body},
+		{"license_id", p.LicenseID},
+	}
+	for _, f := range required {
+		if f.value == "" {
+			errs = append(errs, fmt.Errorf("%s is required", f.name))
+		}
+	}
+
+	if p.ProjectType != "" && !slices.Contains(creatableProjectTypes, p.ProjectType) {
+		errs = append(errs, fmt.Errorf("unknown project_type %q", p.ProjectType))
+	}
+	if p.ClientSide != "" && !slices.Contains(creatableSideSupports, p.ClientSide) {
+		errs = append(errs, fmt.Errorf("unknown client_side %q", p.ClientSide))
+	}
+	if p.ServerSide != "" && !slices.Contains(creatableSideSupports, p.ServerSide) {
+		errs = append(errs, fmt.Errorf("unknown server_side %q", p.ServerSide))
+	}
+	if p.RequestedStatus != "" && !p.RequestedStatus.IsRequestable() {
+		errs = append(errs, fmt.Errorf("requested_status %q is not requestable", p.RequestedStatus))
+	}
+
+	return errors.Join(errs...)
+}
+
 func (s *ProjectsService) Create(ctx context.Context, proj *Project) (*Project, *Response, error) {
+	if proj.License == nil {
+		return nil, nil, errors.New("license is required")
+	}
+
 	projReq := &creatableProject{
 		Slug:                 proj.Slug,
 		Title:                proj.Title,
@@ -175,14 +288,17 @@ func (s *ProjectsService) Create(ctx context.Context, proj *Project) (*Project,
 		ServerSide:           proj.ServerSide,
 		Body:                 proj.Body,
 		LicenseID:            proj.License.ID,
-		RequestedStatus:      *proj.RequestedStatus,
+		RequestedStatus:      deref(proj.RequestedStatus),
 		AdditionalCategories: proj.AdditionalCategories,
-		IssuesURL:            *proj.IssuesURL,
-		SourceURL:            *proj.SourceURL,
-		WikiURL:              *proj.WikiURL,
-		DiscordURL:           *proj.DiscordURL,
+		IssuesURL:            deref(proj.IssuesURL),
+		SourceURL:            deref(proj.SourceURL),
+		WikiURL:              deref(proj.WikiURL),
+		DiscordURL:           deref(proj.DiscordURL),
 		DonationUrls:         proj.DonationUrls,
-		LicenseURL:           *proj.License.URL,
+		LicenseURL:           deref(proj.License.URL),
+	}
+	if err := projReq.validate(); err != nil {
+		return nil, nil, err
 	}
 
 	data, err := s.client.JSONMarshaler(projReq)
@@ -193,6 +309,7 @@ func (s *ProjectsService) Create(ctx context.Context, proj *Project) (*Project,
 	bodyBuf := new(bytes.Buffer)
 	mw := multipart.NewWriter(bodyBuf)
 	mh := make(textproto.MIMEHeader)
+	mh.Set("Content-Disposition", `form-data; name="data"`)
 	mh.Set("Content-Type", "application/json")
 	pw, err := mw.CreatePart(mh)
 	if err != nil {
@@ -207,7 +324,7 @@ func (s *ProjectsService) Create(ctx context.Context, proj *Project) (*Project,
 		return nil, nil, err
 	}
 
-	req, err := s.client.NewFormRequest(http.MethodPost, "project", bodyBuf)
+	req, err := s.client.NewUploadRequest(http.MethodPost, "project", mw.FormDataContentType(), bodyBuf)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -220,6 +337,72 @@ func (s *ProjectsService) Create(ctx context.Context, proj *Project) (*Project,
 	return projRes, res, nil
 }
 
+var (
+	ErrAlreadyExists = errors.New("already exists")
+	ErrSlugTaken     = errors.New("slug is taken by a project of another team")
+)
+
+// CreateIfNotExists creates proj unless its slug is already taken.
+// If the authorized user is a member of the existing project's team,
+// that project is returned with ErrAlreadyExists; otherwise the slug belongs
+// to someone else and ErrSlugTaken is returned without a project.
+// The API honors no idempotency key, so this protects a retried Create
+// from making a duplicate when the first attempt actually succeeded.
+func (s *ProjectsService) CreateIfNotExists(ctx context.Context, proj *Project) (*Project, *Response, error) {
+	_, res, err := s.ValidSlugID(ctx, proj.Slug)
+	if errors.Is(err, ErrNotFound) {
+		return s.Create(ctx, proj)
+	}
+	if err != nil {
+		return nil, res, err
+	}
+
+	existing, res, err := s.Get(ctx, proj.Slug)
+	if err != nil {
+		return nil, res, err
+	}
+	user, res, err := s.client.Users.GetCurrent(ctx)
+	if err != nil {
+		return nil, res, err
+	}
+	members, res, err := s.client.Teams.GetTeam(ctx, existing.Team)
+	if err != nil {
+		return nil, res, err
+	}
+	if !lo.ContainsBy(members, func(m *TeamMember) bool { return m.User != nil && m.User.ID == user.ID }) {
+		return nil, res, fmt.Errorf("%w: %s", ErrSlugTaken, proj.Slug)
+	}
+	return existing, res, ErrAlreadyExists
+}
+
+var ErrInvalidCategory = errors.New("invalid category")
+
+// CreateValidated is like Create, but first checks that Categories and
+// AdditionalCategories are valid for the project type, listing the invalid ones.
+// Categories are requested once per client and then reused for an hour.
+// Use Create to skip the check and the extra request.
+func (s *ProjectsService) CreateValidated(ctx context.Context, proj *Project) (*Project, *Response, error) {
+	categories, err := s.client.Tags.cachedCategories(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	valid := map[string]bool{}
+	for _, c := range categories {
+		if c.ProjectType == proj.ProjectType {
+			valid[c.Name] = true
+		}
+	}
+	invalid := lo.Uniq(lo.Reject(append(slices.Clone(proj.Categories), proj.AdditionalCategories...), func(c string, _ int) bool {
+		return valid[c]
+	}))
+	if len(invalid) != 0 {
+		return nil, nil, fmt.Errorf("%w for %s: %s", ErrInvalidCategory, proj.ProjectType, strings.Join(invalid, ", "))
+	}
+
+	return s.Create(ctx, proj)
+}
+
 type editableProject struct {
 	Slug                 string                `json:"slug,omitempty"`
 	Title                string                `json:"title,omitempty"`
@@ -230,15 +413,38 @@ type editableProject struct {
 	Body                 string                `json:"body,omitempty"`
 	RequestedStatus      ProjectStatus         `json:"requested_status,omitempty"`
 	AdditionalCategories []string              `json:"additional_categories,omitempty"`
-	IssuesURL            string                `json:"issues_url,omitempty"`
-	SourceURL            string                `json:"source_url,omitempty"`
-	WikiURL              string                `json:"wiki_url,omitempty"`
-	DiscordURL           string                `json:"discord_url,omitempty"`
+	IssuesURL            *nullableString       `json:"issues_url,omitempty"`
+	SourceURL            *nullableString       `json:"source_url,omitempty"`
+	WikiURL              *nullableString       `json:"wiki_url,omitempty"`
+	DiscordURL           *nullableString       `json:"discord_url,omitempty"`
 	DonationUrls         []*ProjectDonationURL `json:"donation_urls,omitempty"`
 	LicenseID            string                `json:"license_id,omitempty"`
-	LicenseURL           string                `json:"license_url,omitempty"`
+	LicenseURL           *nullableString       `json:"license_url,omitempty"`
+}
+
+// nullableString is an edit field that is sent as null when empty, clearing it.
+type nullableString string
+
+func (s nullableString) MarshalJSON() ([]byte, error) {
+	if s == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(string(s))
+}
+
+// clearable maps an optional field for an edit request:
+// nil leaves the field unchanged and a pointer to "" clears it.
+func clearable(p *string) *nullableString {
+	if p == nil {
+		return nil
+	}
+	s := nullableString(*p)
+	return &s
 }
 
+// Edit updates the non-empty fields of proj.
+// IssuesURL, SourceURL, WikiURL, DiscordURL and License.URL can be cleared
+// by setting them to a pointer to "", which is sent as null; nil leaves them unchanged.
 func (s *ProjectsService) Edit(ctx context.Context, idSlug string, proj *Project) (*Project, *Response, error) {
 	projReq := &editableProject{
 		Slug:                 proj.Slug,
@@ -248,15 +454,17 @@ func (s *ProjectsService) Edit(ctx context.Context, idSlug string, proj *Project
 		ClientSide:           proj.ClientSide,
 		ServerSide:           proj.ServerSide,
 		Body:                 proj.Body,
-		LicenseID:            proj.License.ID,
-		RequestedStatus:      *proj.RequestedStatus,
+		RequestedStatus:      deref(proj.RequestedStatus),
 		AdditionalCategories: proj.AdditionalCategories,
-		IssuesURL:            *proj.IssuesURL,
-		SourceURL:            *proj.SourceURL,
-		WikiURL:              *proj.WikiURL,
-		DiscordURL:           *proj.DiscordURL,
+		IssuesURL:            clearable(proj.IssuesURL),
+		SourceURL:            clearable(proj.SourceURL),
+		WikiURL:              clearable(proj.WikiURL),
+		DiscordURL:           clearable(proj.DiscordURL),
 		DonationUrls:         proj.DonationUrls,
-		LicenseURL:           *proj.License.URL,
+	}
+	if proj.License != nil {
+		projReq.LicenseID = proj.License.ID
+		projReq.LicenseURL = clearable(proj.License.URL)
 	}
 
 	req, err := s.client.NewRequest(http.MethodPatch, "project/"+idSlug, projReq)
@@ -303,8 +511,12 @@ type ProjectEditAll struct {
 
 // EditAll edits specified fields in all projects at once
 func (s *ProjectsService) EditAll(ctx context.Context, idSlugs []string, params *ProjectEditAll) (*Response, error) {
+	ids, err := s.client.queryArray(idSlugs)
+	if err != nil {
+		return nil, err
+	}
 	q := neturl.Values{}
-	q.Add("ids", queryArray(idSlugs))
+	q.Add("ids", ids)
 
 	req, err := s.client.NewRequest(http.MethodPatch, "projects?"+q.Encode(), params)
 	if err != nil {
@@ -314,6 +526,59 @@ func (s *ProjectsService) EditAll(ctx context.Context, idSlugs []string, params
 	return s.client.Do(ctx, req, nil)
 }
 
+// editAllDonationURL is the element type of the donation url fields of ProjectEditAll.
+type editAllDonationURL = struct {
+	ID       string `json:"id,omitempty"`
+	Platform string `json:"platform,omitempty"`
+	URL      string `json:"url,omitempty"`
+}
+
+// validate reports contradictory add and remove sets and incomplete donation urls at once.
+func (p *ProjectEditAll) validate() error {
+	var errs []error
+	if both := lo.Intersect(p.AddCategories, p.RemoveCategories); len(both) != 0 {
+		errs = append(errs, fmt.Errorf("categories both added and removed: %s", strings.Join(both, ", ")))
+	}
+	if both := lo.Intersect(p.AddAdditionalCategories, p.RemoveAdditionalCategories); len(both) != 0 {
+		errs = append(errs, fmt.Errorf("additional categories both added and removed: %s", strings.Join(both, ", ")))
+	}
+
+	donations := []struct {
+		name string
+		urls []*editAllDonationURL
+	}{
+		{"donation_urls", p.DonationUrls},
+		{"add_donation_urls", p.AddDonationUrls},
+		{"remove_donation_urls", p.RemoveDonationUrls},
+	}
+	for _, d := range donations {
+		for i, u := range d.urls {
+			if u == nil || u.ID == "" || u.URL == "" {
+				errs = append(errs, fmt.Errorf("%s[%d]: id and url are required", d.name, i))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// BatchEditProjects is like EditAll, but first checks that idSlugs is not empty,
+// that no category is both added and removed, and that donation urls have an id and url.
+// Every problem found is returned at once.
+func (s *ProjectsService) BatchEditProjects(ctx context.Context, idSlugs []string, params *ProjectEditAll) (*Response, error) {
+	var errs []error
+	if len(idSlugs) == 0 {
+		errs = append(errs, errors.New("no projects to edit"))
+	}
+	if err := params.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return s.EditAll(ctx, idSlugs, params)
+}
+
 func (s *ProjectsService) Delete(ctx context.Context, idSlug string) (*Response, error) {
 	req, err := s.client.NewRequest(http.MethodDelete, "project/"+idSlug, nil)
 	if err != nil {
@@ -326,17 +591,21 @@ func (s *ProjectsService) Delete(ctx context.Context, idSlug string) (*Response,
 type EditProjectIconParams struct {
 	// Extension of icon file to upload.
 	// Example: "jpeg"
-	Ext  string `url:"ext"`
-	File io.Reader
+	Ext  string    `url:"ext"`
+	File io.Reader `url:"-"`
 }
 
 func (s *ProjectsService) ChangeIcon(ctx context.Context, idSlug string, params *EditProjectIconParams) (*Response, error) {
-	if !slices.Contains(supportedImageExt, params.Ext) {
-		return nil, errors.New("unsupported iamge file")
+	if err := checkImageExt(params.Ext); err != nil {
+		return nil, err
 	}
 	if params.Ext == "jpg" {
 		params.Ext = "jpeg"
 	}
+	file, err := sniffImage(params.Ext, params.File)
+	if err != nil {
+		return nil, err
+	}
 	q, err := query.Values(params)
 	if err != nil {
 		return nil, err
@@ -346,7 +615,7 @@ func (s *ProjectsService) ChangeIcon(ctx context.Context, idSlug string, params
 		http.MethodPatch,
 		fmt.Sprintf("project/%s/icon?%s", idSlug, q.Encode()),
 		"image/"+params.Ext,
-		params.File)
+		file)
 
 	if err != nil {
 		return nil, err
@@ -355,6 +624,21 @@ func (s *ProjectsService) ChangeIcon(ctx context.Context, idSlug string, params
 	return s.client.Do(ctx, req, nil)
 }
 
+// ChangeIconFromFile uploads the image file at path as the project icon.
+// The extension is inferred from the file name.
+func (s *ProjectsService) ChangeIconFromFile(ctx context.Context, idSlug string, path string) (*Response, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return s.ChangeIcon(ctx, idSlug, &EditProjectIconParams{
+		Ext:  imageExtFromPath(path),
+		File: f,
+	})
+}
+
 func (s *ProjectsService) DeleteIcon(ctx context.Context, idSlug string) (*Response, error) {
 	req, err := s.client.NewRequest(http.MethodDelete, fmt.Sprintf("project/%s/icon", idSlug), nil)
 	if err != nil {
@@ -370,16 +654,20 @@ type AddGalleryImageParams struct {
 	Title       string    `url:"title,omitempty"`
 	Description string    `url:"description,omitempty"`
 	Ordering    int       `url:"ordering,omitempty"`
-	File        io.Reader // Required
+	File        io.Reader `url:"-"` // Required
 }
 
 func (s *ProjectsService) AddGalleryImage(ctx context.Context, idSlug string, params *AddGalleryImageParams) (*Response, error) {
-	if !slices.Contains(supportedImageExt, params.Ext) {
-		return nil, errors.New("unsupported iamge file")
+	if err := checkImageExt(params.Ext); err != nil {
+		return nil, err
 	}
 	if params.Ext == "jpg" {
 		params.Ext = "jpeg"
 	}
+	file, err := sniffImage(params.Ext, params.File)
+	if err != nil {
+		return nil, err
+	}
 	q, err := query.Values(params)
 	if err != nil {
 		return nil, err
@@ -389,7 +677,7 @@ func (s *ProjectsService) AddGalleryImage(ctx context.Context, idSlug string, pa
 		http.MethodPost,
 		fmt.Sprintf("project/%s/gallery?%s", idSlug, q.Encode()),
 		"image/"+params.Ext,
-		params.File)
+		file)
 
 	if err != nil {
 		return nil, err
@@ -398,15 +686,35 @@ func (s *ProjectsService) AddGalleryImage(ctx context.Context, idSlug string, pa
 	return s.client.Do(ctx, req, nil)
 }
 
+// AddGalleryImageFromFile uploads the image file at path to the gallery.
+// Ext and File of params are set from the file; params may be nil.
+func (s *ProjectsService) AddGalleryImageFromFile(ctx context.Context, idSlug string, path string, params *AddGalleryImageParams) (*Response, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	p := AddGalleryImageParams{}
+	if params != nil {
+		p = *params
+	}
+	p.Ext = imageExtFromPath(path)
+	p.File = f
+
+	return s.AddGalleryImage(ctx, idSlug, &p)
+}
+
 type EditGalleryImageParams struct {
 	URL         string `url:"url"`
 	Featured    bool   `url:"featured"`
 	Title       string `url:"title,omitempty"`
 	Description string `url:"description,omitempty"`
-	Ordering    int    `url:"ordering,omitempty"`
+	// Nil leaves the ordering unchanged; a pointer to 0 moves the image first.
+	Ordering *int `url:"ordering,omitempty"`
 }
 
-func (s *ProjectsService) EditGalleryImage(ctx context.Context, idSlug string, params *AddGalleryImageParams) (*Response, error) {
+func (s *ProjectsService) EditGalleryImage(ctx context.Context, idSlug string, params *EditGalleryImageParams) (*Response, error) {
 	q, err := query.Values(params)
 	if err != nil {
 		return nil, err
@@ -463,6 +771,101 @@ func (s *ProjectsService) GetDependencies(ctx context.Context, idSlug string) (*
 	return deps, res, nil
 }
 
+type ListProjectVersionsParams struct {
+	// Example: ["fabric"]
+	Loaders []string
+	// Example: ["1.20.1"]
+	GameVersions []string
+	Featured     *bool
+}
+
+func (p *ListProjectVersionsParams) values(c *Client) (neturl.Values, error) {
+	q := neturl.Values{}
+	if p == nil {
+		return q, nil
+	}
+	if len(p.Loaders) != 0 {
+		loaders, err := c.queryArray(p.Loaders)
+		if err != nil {
+			return nil, err
+		}
+		q.Add("loaders", loaders)
+	}
+	if len(p.GameVersions) != 0 {
+		gameVersions, err := c.queryArray(p.GameVersions)
+		if err != nil {
+			return nil, err
+		}
+		q.Add("game_versions", gameVersions)
+	}
+	if p.Featured != nil {
+		q.Add("featured", strconv.FormatBool(*p.Featured))
+	}
+	return q, nil
+}
+
+// GetVersions returns the versions of a project, filtered by params if given.
+func (s *ProjectsService) GetVersions(ctx context.Context, idSlug string, params *ListProjectVersionsParams) ([]*Version, *Response, error) {
+	q, err := params.values(s.client)
+	if err != nil {
+		return nil, nil, err
+	}
+	path := fmt.Sprintf("project/%s/version", idSlug)
+	if len(q) != 0 {
+		path += "?" + q.Encode()
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var versions = []*Version{}
+	res, err := s.client.Do(ctx, req, &versions)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return versions, res, nil
+}
+
+// GetVersionsAll returns a pager over the versions of a project, filtered by params if given,
+// fetching limit versions per page. A non-positive limit defaults to 10.
+//
+//	p := client.Projects.GetVersionsAll("sodium", nil, 50)
+//	for p.Next(ctx) {
+//		for _, v := range p.Page() { ... }
+//	}
+//	if err := p.Err(); err != nil { ... }
+func (s *ProjectsService) GetVersionsAll(idSlug string, params *ListProjectVersionsParams, limit int) *Pager[*Version] {
+	q, err := params.values(s.client)
+	p := doPaginated[*Version](s.client, fmt.Sprintf("project/%s/version", idSlug), q, limit)
+	if err != nil {
+		p.err, p.done = err, true
+	}
+	return p
+}
+
+// GetVersion resolves a version by its number (e.g. "1.2.0") or id within a project.
+// Version numbers are not globally unique, so this is the way to look one up.
+func (s *ProjectsService) GetVersion(ctx context.Context, idSlug, versionNumberOrID string) (*Version, *Response, error) {
+	// "+" is valid in a path but is often decoded as a space, so escape it too.
+	v := strings.ReplaceAll(neturl.PathEscape(versionNumberOrID), "+", "%2B")
+
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("project/%s/version/%s", idSlug, v), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var version = new(Version)
+	res, err := s.client.Do(ctx, req, version)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return version, res, nil
+}
+
 func (s *ProjectsService) Follow(ctx context.Context, idSlug string) (*Response, error) {
 	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("project/%s/follow", idSlug), nil)
 	if err != nil {
@@ -0,0 +1,286 @@
+package labrinth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	neturl "net/url"
+	"testing"
+)
+
+func minimalProject() *Project {
+	return &Project{
+		Slug:        "my-mod",
+		Title:       "My Mod",
+		Description: "A mod",
+		ProjectType: ProjectType_Mod,
+		Categories:  []string{"utility"},
+		ClientSide:  ProjectSideSupport_Required,
+		ServerSide:  ProjectSideSupport_Optional,
+		Body:        "body",
+		License:     &ProjectLicense{ID: "MIT"},
+	}
+}
+
+func TestCreateNilOptionals(t *testing.T) {
+	var data map[string]any
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := json.Unmarshal([]byte(r.FormValue("data")), &data); err != nil {
+			t.Errorf("data part: %v", err)
+		}
+		w.Write([]byte(`{"id":"abc"}`))
+	})
+
+	proj, _, err := c.Projects.Create(context.Background(), minimalProject())
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if proj.ID != "abc" {
+		t.Errorf("ID = %q, want abc", proj.ID)
+	}
+	if data["license_id"] != "MIT" {
+		t.Errorf("license_id = %v, want MIT", data["license_id"])
+	}
+	for _, k := range []string{"requested_status", "issues_url", "source_url", "wiki_url", "discord_url", "license_url"} {
+		if v, ok := data[k]; ok {
+			t.Errorf("%s = %v, want omitted", k, v)
+		}
+	}
+}
+
+func TestCreateNilLicense(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	})
+	proj := minimalProject()
+	proj.License = nil
+
+	_, _, err := c.Projects.Create(context.Background(), proj)
+	if err == nil || err.Error() != "license is required" {
+		t.Errorf("err = %v, want license is required", err)
+	}
+}
+
+func TestEditNilLicense(t *testing.T) {
+	var data map[string]any
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&data)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if _, _, err := c.Projects.Edit(context.Background(), "abc", &Project{Title: "New"}); err != nil {
+		t.Fatalf("Edit: %v", err)
+	}
+	if data["title"] != "New" {
+		t.Errorf("title = %v, want New", data["title"])
+	}
+	for _, k := range []string{"license_id", "license_url"} {
+		if v, ok := data[k]; ok {
+			t.Errorf("%s = %v, want omitted", k, v)
+		}
+	}
+}
+
+func TestEditGalleryImage(t *testing.T) {
+	zero := 0
+	tests := []struct {
+		name   string
+		params *EditGalleryImageParams
+		want   neturl.Values
+	}{
+		{
+			name:   "all fields",
+			params: &EditGalleryImageParams{URL: "https://cdn/a.png", Featured: true, Title: "T", Description: "D", Ordering: &zero},
+			want:   neturl.Values{"url": {"https://cdn/a.png"}, "featured": {"true"}, "title": {"T"}, "description": {"D"}, "ordering": {"0"}},
+		},
+		{
+			name:   "nil ordering",
+			params: &EditGalleryImageParams{URL: "https://cdn/a.png"},
+			want:   neturl.Values{"url": {"https://cdn/a.png"}, "featured": {"false"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var method, path string
+			var got neturl.Values
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				method, path, got = r.Method, r.URL.Path, r.URL.Query()
+				w.WriteHeader(http.StatusNoContent)
+			})
+			if _, err := c.Projects.EditGalleryImage(context.Background(), "abc", tt.params); err != nil {
+				t.Fatalf("EditGalleryImage: %v", err)
+			}
+			if method != http.MethodPatch || path != "/v2/project/abc/gallery" {
+				t.Errorf("got %s %s, want PATCH /v2/project/abc/gallery", method, path)
+			}
+			if got.Encode() != tt.want.Encode() {
+				t.Errorf("query = %s, want %s", got.Encode(), tt.want.Encode())
+			}
+		})
+	}
+}
+
+func TestCreateMultipartBoundary(t *testing.T) {
+	var parts []string
+	var data map[string]any
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || mediaType != "multipart/form-data" || params["boundary"] == "" {
+			t.Errorf("Content-Type = %q, want multipart/form-data with a boundary", r.Header.Get("Content-Type"))
+			return
+		}
+		mr := multipart.NewReader(r.Body, params["boundary"])
+		for {
+			p, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Errorf("NextPart: %v", err)
+				return
+			}
+			parts = append(parts, p.FormName()+" "+p.Header.Get("Content-Type"))
+			if p.FormName() == "data" {
+				json.NewDecoder(p).Decode(&data)
+			}
+		}
+		w.Write([]byte(`{"id":"abc"}`))
+	})
+
+	if _, _, err := c.Projects.Create(context.Background(), minimalProject()); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if len(parts) != 1 || parts[0] != "data application/json" {
+		t.Errorf("parts = %q, want one data part of application/json", parts)
+	}
+	if data["slug"] != "my-mod" || data["project_type"] != "mod" {
+		t.Errorf("data = %v", data)
+	}
+}
+
+func TestCreateIfNotExists(t *testing.T) {
+	tests := []struct {
+		name    string
+		member  string
+		wantErr error
+		wantID  string
+	}{
+		{"own project", "me", ErrAlreadyExists, "abc"},
+		{"stranger's project", "someone", ErrSlugTaken, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /v2/project/my-mod/check", func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"id":"abc"}`))
+			})
+			mux.HandleFunc("GET /v2/project/my-mod", func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"id":"abc","slug":"my-mod","team":"t1"}`))
+			})
+			mux.HandleFunc("GET /v2/user", func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`{"id":"me"}`))
+			})
+			mux.HandleFunc("GET /v2/team/t1/members", func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(`[{"team_id":"t1","user":{"id":"` + tt.member + `"}}]`))
+			})
+			mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			})
+			c := newTestClient(t, mux.ServeHTTP)
+
+			proj, _, err := c.Projects.CreateIfNotExists(context.Background(), minimalProject())
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+			var got string
+			if proj != nil {
+				got = proj.ID
+			}
+			if got != tt.wantID {
+				t.Errorf("ID = %q, want %q", got, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestCreateIfNotExistsFreeSlug(t *testing.T) {
+	var created bool
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/project/my-mod/check":
+			w.WriteHeader(http.StatusNotFound)
+		case "POST /v2/project":
+			created = true
+			w.Write([]byte(`{"id":"new"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	})
+	proj, _, err := c.Projects.CreateIfNotExists(context.Background(), minimalProject())
+	if err != nil || !created || proj.ID != "new" {
+		t.Errorf("got %v, %v, created %v", proj, err, created)
+	}
+}
+
+func TestGetAllGetRandomDecodeArray(t *testing.T) {
+	var query neturl.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write([]byte(`[{"id":"a","slug":"one"},{"id":"b","slug":"two"}]`))
+	})
+	ctx := context.Background()
+
+	all, _, err := c.Projects.GetAll(ctx, []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("GetAll: %v", err)
+	}
+	if query.Get("ids") != `["a","b"]` {
+		t.Errorf("ids = %s", query.Get("ids"))
+	}
+	random, _, err := c.Projects.GetRandom(ctx, 2)
+	if err != nil {
+		t.Fatalf("GetRandom: %v", err)
+	}
+	if query.Get("count") != "2" {
+		t.Errorf("count = %s", query.Get("count"))
+	}
+
+	for name, projs := range map[string][]*Project{"GetAll": all, "GetRandom": random} {
+		if len(projs) != 2 || projs[0].ID != "a" || projs[1].Slug != "two" {
+			t.Errorf("%s = %v, want projects a and b", name, projs)
+		}
+	}
+}
+
+func TestGetOrganizationNone(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL)
+	})
+	empty := ""
+	for _, org := range []*string{nil, &empty} {
+		got, res, err := c.Projects.GetOrganization(context.Background(), &Project{Organization: org})
+		if got != nil || res != nil || err != nil {
+			t.Errorf("GetOrganization = %v, %v, %v, want all nil", got, res, err)
+		}
+	}
+}
+
+func TestGetOrganization(t *testing.T) {
+	var path string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		w.Write([]byte(`{"id":"org1","slug":"my-org"}`))
+	})
+	id := "org1"
+	org, _, err := c.Projects.GetOrganization(context.Background(), &Project{Organization: &id})
+	if err != nil {
+		t.Fatalf("GetOrganization: %v", err)
+	}
+	if path != "/v3/organization/org1" || org.ID != "org1" {
+		t.Errorf("got %s from %s, want org1 from /v3/organization/org1", org.ID, path)
+	}
+}
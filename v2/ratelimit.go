@@ -0,0 +1,63 @@
+package labrinth
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter gates outgoing requests to avoid hitting the API ratelimit.
+type RateLimiter interface {
+	// Wait blocks until a request is allowed or ctx is done.
+	Wait(ctx context.Context) error
+	// Update is called with the ratelimit parsed from each response.
+	Update(rate Rate)
+}
+
+// NewRateLimiter returns a RateLimiter that follows the X-Ratelimit-* headers:
+// once the remaining requests run out, it blocks until the window resets.
+func NewRateLimiter() RateLimiter {
+	return &headerRateLimiter{}
+}
+
+type headerRateLimiter struct {
+	mu        sync.Mutex
+	known     bool
+	remaining int
+	reset     time.Time
+}
+
+func (l *headerRateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		if !l.known || !now.Before(l.reset) {
+			l.known = false
+			l.mu.Unlock()
+			return nil
+		}
+		if l.remaining > 0 {
+			l.remaining--
+			l.mu.Unlock()
+			return nil
+		}
+		wait := l.reset.Sub(now)
+		l.mu.Unlock()
+
+		if err := sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+func (l *headerRateLimiter) Update(rate Rate) {
+	if rate.Limit == 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.known = true
+	l.remaining = rate.Remaining
+	l.reset = time.Now().Add(time.Duration(rate.Reset) * time.Second)
+}
@@ -0,0 +1,77 @@
+package labrinth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type ReportsService service
+
+type SubmitReportParams struct {
+	// One of the report types from TagsService.GetReportTypes.
+	ReportType string         `json:"report_type"` // Required
+	ItemID     string         `json:"item_id"`     // Required
+	ItemType   ReportItemType `json:"item_type"`   // Required
+	Body       string         `json:"body"`        // Required
+}
+
+func (s *ReportsService) Submit(ctx context.Context, params *SubmitReportParams) (*Report, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "report", params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var report = new(Report)
+	res, err := s.client.Do(ctx, req, report)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return report, res, nil
+}
+
+// GetOwn returns the reports submitted by the authorized user.
+func (s *ReportsService) GetOwn(ctx context.Context) ([]*Report, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "report", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reports = []*Report{}
+	res, err := s.client.Do(ctx, req, &reports)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return reports, res, nil
+}
+
+func (s *ReportsService) Get(ctx context.Context, id string) (*Report, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("report/%s", id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var report = new(Report)
+	res, err := s.client.Do(ctx, req, report)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return report, res, nil
+}
+
+type EditReportParams struct {
+	Body   *string `json:"body,omitempty"`
+	Closed *bool   `json:"closed,omitempty"` // Only moderators can close reports.
+}
+
+func (s *ReportsService) Edit(ctx context.Context, id string, params *EditReportParams) (*Response, error) {
+	req, err := s.client.NewRequest(http.MethodPatch, fmt.Sprintf("report/%s", id), params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,40 @@
+package labrinth
+
+import (
+	"context"
+	"net/http"
+)
+
+type requestOptionsKey struct{}
+
+// WithRequestOptions returns a copy of ctx carrying opts, which Do applies to
+// every request made with the context, after any previously attached options.
+// They run after the options given to NewRequest and the other builders,
+// so they take precedence over per-call options.
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	prev := requestOptionsFromContext(ctx)
+	all := make([]RequestOption, 0, len(prev)+len(opts))
+	all = append(all, prev...)
+	all = append(all, opts...)
+	return context.WithValue(ctx, requestOptionsKey{}, all)
+}
+
+func requestOptionsFromContext(ctx context.Context) []RequestOption {
+	opts, _ := ctx.Value(requestOptionsKey{}).([]RequestOption)
+	return opts
+}
+
+// withContext binds req to ctx and applies the options attached to ctx.
+// The request is cloned first so the caller's headers are left untouched.
+func withContext(ctx context.Context, req *http.Request) *http.Request {
+	opts := requestOptionsFromContext(ctx)
+	if len(opts) == 0 {
+		return req.WithContext(ctx)
+	}
+
+	req = req.Clone(ctx)
+	for _, opt := range opts {
+		opt(req)
+	}
+	return req
+}
@@ -0,0 +1,149 @@
+package labrinth
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type RetryPolicy struct {
+	// Maximum number of retries after the first attempt.
+	MaxRetries int
+	// Upper bound of a single wait. Zero means no limit.
+	MaxWait time.Duration
+	// Jitter randomizes waits so that concurrent requests do not retry in lockstep.
+	// When the response does not tell when to retry, the wait doubles each attempt
+	// from 1s and a random part of it is waited (full jitter).
+	// Otherwise up to 1s is added to the wait the response tells.
+	Jitter bool
+	// Rand is the source of jitter, e.g. rand.New(rand.NewSource(1)) for reproducible waits.
+	// It must not be shared between clients. Nil uses a source per client.
+	Rand *rand.Rand
+}
+
+// Wait used when a 429 response does not tell when the ratelimit window resets.
+const defaultRetryWait = 1 * time.Second
+
+// Cap of the exponent of the jittered wait, so that it cannot overflow.
+const maxJitterShift = 10
+
+// jitterRand guards the sources of jitter, since rand.Rand is not safe for concurrent use.
+type jitterRand struct {
+	mu  sync.Mutex
+	own *rand.Rand
+}
+
+// duration returns a random duration in [0, n) from r, or the client's own source if r is nil.
+func (j *jitterRand) duration(r *rand.Rand, n time.Duration) time.Duration {
+	if n <= 0 {
+		return 0
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if r == nil {
+		if j.own == nil {
+			j.own = rand.New(rand.NewSource(time.Now().UnixNano()))
+		}
+		r = j.own
+	}
+	return time.Duration(r.Int63n(int64(n)))
+}
+
+// sendWithRetry performs req, retrying it according to the retry policy
+// while the server responds 429 Too Many Requests.
+func (c *Client) sendWithRetry(ctx context.Context, req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		// Every attempt gets a fresh body, so that neither a retry
+		// nor a later Do with the same request sends a drained one.
+		if err := rewindBody(req); err != nil {
+			return nil, err
+		}
+		if c.rateLimiter != nil {
+			if err := c.rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		start := time.Now()
+		res, err := c.transport.Do(req)
+		c.log(req, res, err, time.Since(start))
+		if err != nil {
+			return nil, err
+		}
+		if c.rateLimiter != nil {
+			c.rateLimiter.Update(parseRate(res))
+		}
+		if !c.shouldRetry(req, res, attempt) {
+			decodeBody(res)
+			return res, nil
+		}
+
+		wait := c.retryWait(parseRate(res), attempt)
+		io.Copy(io.Discard, res.Body)
+		res.Body.Close()
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *Client) shouldRetry(req *http.Request, res *http.Response, attempt int) bool {
+	if c.retryPolicy == nil || attempt >= c.retryPolicy.MaxRetries {
+		return false
+	}
+	if res.StatusCode != http.StatusTooManyRequests {
+		return false
+	}
+	return replayable(req)
+}
+
+// replayable reports whether req can be sent again,
+// since a consumed body can only be replayed if it can be recreated.
+func replayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func (c *Client) retryWait(rate Rate, attempt int) time.Duration {
+	p := c.retryPolicy
+	wait := rate.retryWait()
+	switch {
+	case wait > 0 && p.Jitter:
+		wait += c.jitter.duration(p.Rand, defaultRetryWait)
+	case p.Jitter:
+		wait = c.jitter.duration(p.Rand, defaultRetryWait<<min(attempt, maxJitterShift))
+	case wait <= 0:
+		wait = defaultRetryWait
+	}
+	if p.MaxWait > 0 && wait > p.MaxWait {
+		wait = p.MaxWait
+	}
+	return wait
+}
+
+func rewindBody(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,195 @@
+package labrinth
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// bodyRecorder records the bodies of the requests it serves.
+type bodyRecorder struct {
+	mu     sync.Mutex
+	bodies []string
+}
+
+func (r *bodyRecorder) record(req *http.Request) int {
+	data, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bodies = append(r.bodies, string(data))
+	return len(r.bodies)
+}
+
+func TestRetryTooManyRequests(t *testing.T) {
+	rec := &bodyRecorder{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if rec.record(r) == 1 {
+			w.Header().Set(headerRateReset, "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"id":"abc"}`))
+	}, WithRetry(&RetryPolicy{MaxRetries: 1, MaxWait: time.Millisecond}))
+
+	req, err := c.NewRequest(http.MethodPost, "report", map[string]string{"item_id": "abc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct{ ID string }
+	res, err := c.Do(context.Background(), req, &got)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if res.StatusCode != http.StatusOK || got.ID != "abc" {
+		t.Errorf("got %d %q, want 200 %q", res.StatusCode, got.ID, "abc")
+	}
+
+	want := `{"item_id":"abc"}`
+	if len(rec.bodies) != 2 || rec.bodies[0] != want || rec.bodies[1] != want {
+		t.Errorf("bodies = %q, want %q twice", rec.bodies, want)
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	rec := &bodyRecorder{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		rec.record(r)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}, WithRetry(&RetryPolicy{MaxRetries: 2, MaxWait: time.Millisecond}))
+
+	req, _ := c.NewRequest(http.MethodGet, "project/abc", nil)
+	_, err := c.Do(context.Background(), req, nil)
+	if _, ok := err.(*RateLimitError); !ok {
+		t.Errorf("err = %v, want *RateLimitError", err)
+	}
+	if len(rec.bodies) != 3 {
+		t.Errorf("sent %d requests, want 3", len(rec.bodies))
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"120", 120 * time.Second},
+		{" 5 ", 5 * time.Second},
+		{"-3", 0},
+		{now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second},
+		{now.Add(30 * time.Second).Format(time.RFC1123), 30 * time.Second},
+		{now.Add(45 * time.Second).In(time.FixedZone("JST", 9*60*60)).Format(time.RFC1123Z), 45 * time.Second},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0},
+		{"soon", 0},
+	}
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.in, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestRateRetryWait(t *testing.T) {
+	tests := []struct {
+		reset, retryAfter string
+		want              time.Duration
+	}{
+		{"10", "", 10 * time.Second},
+		{"", "7", 7 * time.Second},
+		{"10", "30", 30 * time.Second},
+		{"30", "10", 30 * time.Second},
+		{"", "", 0},
+	}
+	for _, tt := range tests {
+		res := &http.Response{Header: http.Header{}}
+		if tt.reset != "" {
+			res.Header.Set(headerRateReset, tt.reset)
+		}
+		if tt.retryAfter != "" {
+			res.Header.Set(headerRetryAfter, tt.retryAfter)
+		}
+		if got := parseRate(res).retryWait(); got != tt.want {
+			t.Errorf("reset %q, retry-after %q: wait = %v, want %v", tt.reset, tt.retryAfter, got, tt.want)
+		}
+	}
+}
+
+func TestRateLimitErrorRetryAfter(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateReset, "2")
+		w.Header().Set(headerRetryAfter, "4")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	req, _ := c.NewRequest(http.MethodGet, "project/abc", nil)
+	_, err := c.Do(context.Background(), req, nil)
+	rle, ok := err.(*RateLimitError)
+	if !ok || rle.RetryAfter != 4*time.Second {
+		t.Errorf("err = %v, want *RateLimitError with RetryAfter 4s", err)
+	}
+}
+
+func TestRetrySendsIdenticalBody(t *testing.T) {
+	want := `{"body":"` + strings.Repeat("x", 4096) + `"}`
+	tests := []struct {
+		name string
+		// status returns the response to the nth request.
+		status func(n int, r *http.Request) int
+		opts   []Option
+		setup  func(c *Client)
+	}{
+		{
+			name: "429 retry",
+			status: func(n int, r *http.Request) int {
+				if n == 1 {
+					return http.StatusTooManyRequests
+				}
+				return http.StatusOK
+			},
+			opts: []Option{WithRetry(&RetryPolicy{MaxRetries: 1, MaxWait: time.Millisecond})},
+		},
+		{
+			name: "401 token refresh",
+			status: func(n int, r *http.Request) int {
+				if r.Header.Get("Authorization") != "fresh" {
+					return http.StatusUnauthorized
+				}
+				return http.StatusOK
+			},
+			setup: func(c *Client) {
+				tokens := []string{"expired", "fresh"}
+				c.SetTokenProvider(func(ctx context.Context) (string, error) {
+					token := tokens[0]
+					tokens = tokens[1:]
+					return token, nil
+				})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &bodyRecorder{}
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status(rec.record(r), r))
+			}, tt.opts...)
+			if tt.setup != nil {
+				tt.setup(c)
+			}
+
+			req, err := c.NewRequest(http.MethodPost, "report", json.RawMessage(want))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := c.Do(context.Background(), req, nil); err != nil {
+				t.Fatalf("Do: %v", err)
+			}
+			if len(rec.bodies) != 2 || rec.bodies[0] != want || rec.bodies[1] != want {
+				t.Errorf("sent %d bodies, want 2 identical to the original", len(rec.bodies))
+			}
+		})
+	}
+}
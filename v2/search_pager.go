@@ -0,0 +1,88 @@
+package labrinth
+
+import "context"
+
+// Default number of results per page, same as the API.
+const defaultSearchLimit = 10
+
+// SearchPager iterates over search results page by page.
+//
+//	p := client.Projects.SearchAll(params)
+//	for p.Next(ctx) {
+//		for _, hit := range p.Hits() { ... }
+//	}
+//	if err := p.Err(); err != nil { ... }
+type SearchPager struct {
+	s      *ProjectsService
+	params SearchParams
+	result *SearchResult
+	res    *Response
+	err    error
+	done   bool
+}
+
+// SearchAll returns a pager that advances Offset page by page
+// until all of the total hits have been fetched.
+func (s *ProjectsService) SearchAll(params *SearchParams) *SearchPager {
+	p := &SearchPager{s: s}
+	if params != nil {
+		p.params = *params
+	}
+	if p.params.Limit <= 0 {
+		p.params.Limit = defaultSearchLimit
+	}
+	return p
+}
+
+// Next fetches the next page. It returns false when there are no more results,
+// an error occurred, or ctx is done.
+func (p *SearchPager) Next(ctx context.Context) bool {
+	if p.done {
+		return false
+	}
+	if err := ctx.Err(); err != nil {
+		p.err = err
+		p.done = true
+		return false
+	}
+
+	result, res, err := p.s.Search(ctx, &p.params)
+	p.res = res
+	if err != nil {
+		p.err = err
+		p.done = true
+		return false
+	}
+	if len(result.Hits) == 0 {
+		p.done = true
+		return false
+	}
+
+	p.result = result
+	p.params.Offset = result.NextOffset()
+	p.done = !result.HasMore()
+	return true
+}
+
+// Hits returns the hits of the current page.
+func (p *SearchPager) Hits() []*SearchHit {
+	if p.result == nil {
+		return nil
+	}
+	return p.result.Hits
+}
+
+// Result returns the current page.
+func (p *SearchPager) Result() *SearchResult {
+	return p.result
+}
+
+// Response returns the response of the last request.
+func (p *SearchPager) Response() *Response {
+	return p.res
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *SearchPager) Err() error {
+	return p.err
+}
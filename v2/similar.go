@@ -0,0 +1,34 @@
+package labrinth
+
+import (
+	"labrinth/facets"
+
+	"github.com/samber/lo"
+)
+
+// SimilarSearchParams returns search params for projects like p:
+// the same project type and primary loader, and any of its categories, by relevance.
+// The results can include p itself; remove it with ExcludeFromHits.
+func (p *Project) SimilarSearchParams() *SearchParams {
+	f := facets.New(facets.ProjectTypeIs(p.ProjectType))
+	if len(p.Loaders) != 0 {
+		f.And(facets.Categories().Equal(p.Loaders[0]))
+	}
+	if len(p.Categories) != 0 {
+		f.Or(lo.Map(p.Categories, func(c string, _ int) facets.FacetProp {
+			return facets.Categories().Equal(c)
+		})...)
+	}
+
+	return &SearchParams{
+		Facets: f.String(),
+		Index:  SearchIndex_Relevance,
+	}
+}
+
+// ExcludeFromHits returns hits without p itself.
+func (p *Project) ExcludeFromHits(hits []*SearchHit) []*SearchHit {
+	return lo.Filter(hits, func(h *SearchHit, _ int) bool {
+		return h.ProjectID != p.ID
+	})
+}
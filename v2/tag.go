@@ -0,0 +1,133 @@
+package labrinth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type TagsService service
+
+func (s *TagsService) GetCategories(ctx context.Context) ([]*Category, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "tag/category", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var categories = []*Category{}
+	res, err := s.client.Do(ctx, req, &categories)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return categories, res, nil
+}
+
+// How long cachedCategories reuses the categories before requesting them again.
+const categoryCacheTTL = time.Hour
+
+// categoryCache holds the categories fetched for a client.
+type categoryCache struct {
+	mu      sync.Mutex
+	list    []*Category
+	fetched time.Time
+	// Fetch in progress, shared by concurrent callers; nil when there is none.
+	call *categoryCall
+}
+
+type categoryCall struct {
+	done chan struct{}
+	list []*Category
+	err  error
+}
+
+// cachedCategories is like GetCategories, but reuses the categories for categoryCacheTTL.
+// Concurrent callers share one request, each waiting only as long as its ctx allows.
+// Failed requests are not cached.
+func (s *TagsService) cachedCategories(ctx context.Context) ([]*Category, error) {
+	cc := &s.client.categories
+	for {
+		cc.mu.Lock()
+		if cc.list != nil && time.Since(cc.fetched) < categoryCacheTTL {
+			list := cc.list
+			cc.mu.Unlock()
+			return list, nil
+		}
+		call := cc.call
+		if call == nil {
+			call = &categoryCall{done: make(chan struct{})}
+			cc.call = call
+			cc.mu.Unlock()
+			return s.fetchCategories(ctx, call)
+		}
+		cc.mu.Unlock()
+
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		// Only try again when the fetch failed because its own caller gave up.
+		if !errors.Is(call.err, context.Canceled) && !errors.Is(call.err, context.DeadlineExceeded) {
+			return call.list, call.err
+		}
+	}
+}
+
+func (s *TagsService) fetchCategories(ctx context.Context, call *categoryCall) ([]*Category, error) {
+	cc := &s.client.categories
+	call.list, _, call.err = s.GetCategories(ctx)
+
+	cc.mu.Lock()
+	if call.err == nil {
+		cc.list, cc.fetched = call.list, time.Now()
+	}
+	cc.call = nil
+	cc.mu.Unlock()
+	close(call.done)
+	return call.list, call.err
+}
+
+func (s *TagsService) GetDonationPlatforms(ctx context.Context) ([]*DonationPlatform, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "tag/donation_platform", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var platforms = []*DonationPlatform{}
+	res, err := s.client.Do(ctx, req, &platforms)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return platforms, res, nil
+}
+
+func (s *TagsService) GetReportTypes(ctx context.Context) ([]string, *Response, error) {
+	return s.getStrings(ctx, "tag/report_type")
+}
+
+func (s *TagsService) GetProjectTypes(ctx context.Context) ([]string, *Response, error) {
+	return s.getStrings(ctx, "tag/project_type")
+}
+
+func (s *TagsService) GetSideTypes(ctx context.Context) ([]string, *Response, error) {
+	return s.getStrings(ctx, "tag/side_type")
+}
+
+func (s *TagsService) getStrings(ctx context.Context, path string) ([]string, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tags = []string{}
+	res, err := s.client.Do(ctx, req, &tags)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return tags, res, nil
+}
@@ -0,0 +1,148 @@
+package labrinth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// categoryServer serves the categories, blocking each request until release is closed.
+func categoryServer(t *testing.T, release <-chan struct{}) (c *Client, started <-chan struct{}, requests *atomic.Int32) {
+	t.Helper()
+	s := make(chan struct{}, 16)
+	requests = new(atomic.Int32)
+	c = newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		s <- struct{}{}
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"name":"adventure","project_type":"mod","header":"categories","icon":""}]`))
+	})
+	return c, s, requests
+}
+
+func TestCachedCategoriesShared(t *testing.T) {
+	release := make(chan struct{})
+	c, started, requests := categoryServer(t, release)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			list, err := c.Tags.cachedCategories(ctx)
+			if err != nil || len(list) != 1 {
+				t.Errorf("cachedCategories = %v, %v", list, err)
+			}
+		}()
+	}
+	<-started
+	close(release)
+	wg.Wait()
+
+	if _, err := c.Tags.cachedCategories(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("requests = %d, want 1", n)
+	}
+
+	c.categories.mu.Lock()
+	c.categories.fetched = c.categories.fetched.Add(-categoryCacheTTL)
+	c.categories.mu.Unlock()
+	if _, err := c.Tags.cachedCategories(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("requests after the TTL = %d, want 2", n)
+	}
+}
+
+func TestCachedCategoriesWaiterContext(t *testing.T) {
+	release := make(chan struct{})
+	c, started, requests := categoryServer(t, release)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.Tags.cachedCategories(context.Background())
+		done <- err
+	}()
+	<-started
+
+	// A waiter gives up with its own context while the fetch is in progress.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.Tags.cachedCategories(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("cachedCategories: %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Errorf("cachedCategories: %v", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("requests = %d, want 1", n)
+	}
+}
+
+func TestCachedCategoriesCanceledFetch(t *testing.T) {
+	release := make(chan struct{})
+	c, started, requests := categoryServer(t, release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.Tags.cachedCategories(ctx)
+		done <- err
+	}()
+	<-started
+
+	waiter := make(chan error, 1)
+	go func() {
+		_, err := c.Tags.cachedCategories(context.Background())
+		waiter <- err
+	}()
+
+	// The fetching caller gives up, so the waiter requests the categories itself.
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("cachedCategories: %v, want %v", err, context.Canceled)
+	}
+	close(release)
+	if err := <-waiter; err != nil {
+		t.Errorf("waiter: %v", err)
+	}
+	if n := requests.Load(); n > 2 {
+		t.Errorf("requests = %d, want at most 2", n)
+	}
+}
+
+func TestCachedCategoriesErrorNotCached(t *testing.T) {
+	var requests atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_input","description":"bad"}`))
+			return
+		}
+		w.Write([]byte(`[{"name":"adventure","project_type":"mod","header":"categories","icon":""}]`))
+	})
+	ctx := context.Background()
+
+	if _, err := c.Tags.cachedCategories(ctx); !errors.Is(err, ErrInvalidInput) {
+		t.Fatalf("cachedCategories: %v, want %v", err, ErrInvalidInput)
+	}
+	list, err := c.Tags.cachedCategories(ctx)
+	if err != nil || len(list) != 1 {
+		t.Errorf("cachedCategories = %v, %v, want the categories", list, err)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("requests = %d, want 2", n)
+	}
+}
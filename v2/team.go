@@ -0,0 +1,137 @@
+package labrinth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+
+	"github.com/samber/lo"
+	"github.com/shopspring/decimal"
+)
+
+type TeamsService service
+
+func (s *TeamsService) GetProjectTeam(ctx context.Context, projectIDSlug string) ([]*TeamMember, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("project/%s/members", projectIDSlug), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var members = []*TeamMember{}
+	res, err := s.client.Do(ctx, req, &members)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return members, res, nil
+}
+
+func (s *TeamsService) GetTeam(ctx context.Context, teamID string) ([]*TeamMember, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("team/%s/members", teamID), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var members = []*TeamMember{}
+	res, err := s.client.Do(ctx, req, &members)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return members, res, nil
+}
+
+// GetTeams returns the members of each team.
+// Duplicated IDs are requested only once, and no request is made for empty ids.
+func (s *TeamsService) GetTeams(ctx context.Context, ids []string) ([][]*TeamMember, *Response, error) {
+	ids = lo.Uniq(ids)
+	if len(ids) == 0 {
+		return [][]*TeamMember{}, nil, nil
+	}
+
+	idsQuery, err := s.client.queryArray(ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	q := neturl.Values{}
+	q.Add("ids", idsQuery)
+
+	req, err := s.client.NewRequest(http.MethodGet, "teams?"+q.Encode(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var teams = [][]*TeamMember{}
+	res, err := s.client.Do(ctx, req, &teams)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return teams, res, nil
+}
+
+type teamUserParams struct {
+	UserID string `json:"user_id"`
+}
+
+// AddMember invites a user to a team.
+// Failures are returned as *ErrorResponse with the API error code.
+func (s *TeamsService) AddMember(ctx context.Context, teamID, userID string) (*Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("team/%s/members", teamID), &teamUserParams{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+type EditTeamMemberParams struct {
+	Role         *string             `json:"role,omitempty"`
+	Permissions  *ProjectPermissions `json:"permissions,omitempty"`
+	PayoutsSplit *decimal.Decimal    `json:"payouts_split,omitempty"`
+	Ordering     *int                `json:"ordering,omitempty"`
+}
+
+func (s *TeamsService) EditMember(ctx context.Context, teamID, userID string, params *EditTeamMemberParams) (*Response, error) {
+	if params.Permissions != nil && !params.Permissions.IsValid() {
+		return nil, errors.New("permissions contains unknown bits")
+	}
+
+	req, err := s.client.NewRequest(http.MethodPatch, fmt.Sprintf("team/%s/members/%s", teamID, userID), params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *TeamsService) RemoveMember(ctx context.Context, teamID, userID string) (*Response, error) {
+	req, err := s.client.NewRequest(http.MethodDelete, fmt.Sprintf("team/%s/members/%s", teamID, userID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// Join accepts an invite to a team.
+// It requires authentication; the API responds 401 otherwise.
+func (s *TeamsService) Join(ctx context.Context, teamID string) (*Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("team/%s/join", teamID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+func (s *TeamsService) TransferOwnership(ctx context.Context, teamID, userID string) (*Response, error) {
+	req, err := s.client.NewRequest(http.MethodPatch, fmt.Sprintf("team/%s/owner", teamID), &teamUserParams{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,46 @@
+package labrinth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type ThreadsService service
+
+type sendMessageParams struct {
+	Body *ThreadMessageBody `json:"body"`
+}
+
+// SendMessage posts a text message to a thread.
+func (s *ThreadsService) SendMessage(ctx context.Context, threadID string, body string) (*Response, error) {
+	if body == "" {
+		return nil, errors.New("message body is empty")
+	}
+
+	params := &sendMessageParams{
+		Body: &ThreadMessageBody{
+			Type: ThreadMessageType_Text,
+			Body: body,
+		},
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, fmt.Sprintf("thread/%s", threadID), params)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteMessage removes a single message from a thread.
+// Failures are returned as *ErrorResponse; a 403 means missing moderation permission.
+func (s *ThreadsService) DeleteMessage(ctx context.Context, messageID string) (*Response, error) {
+	req, err := s.client.NewRequest(http.MethodDelete, fmt.Sprintf("message/%s", messageID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
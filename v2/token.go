@@ -0,0 +1,91 @@
+package labrinth
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// TokenProvider returns the current token, e.g. by refreshing an OAuth token.
+type TokenProvider func(ctx context.Context) (string, error)
+
+// tokenSource caches the token of a TokenProvider until a 401 response.
+type tokenSource struct {
+	provide TokenProvider
+
+	mu    sync.Mutex
+	token string
+	valid bool
+}
+
+// get returns the cached token, calling the provider if there is none
+// or the cached one is stale, the token a request was rejected with.
+// Concurrent requests rejected with the same token thus refresh it only once.
+func (t *tokenSource) get(ctx context.Context, stale *string) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.valid && (stale == nil || t.token != *stale) {
+		return t.token, nil
+	}
+
+	token, err := t.provide(ctx)
+	if err != nil {
+		t.valid = false
+		return "", err
+	}
+	t.token, t.valid = normalizeToken(token), true
+	return t.token, nil
+}
+
+// SetTokenProvider makes the client get its token from p, caching it until
+// a response is 401 Unauthorized, when p is called again and the request retried once.
+// It takes precedence over SetToken and SetAuthProvider. A nil p disables it.
+func (c *Client) SetTokenProvider(p TokenProvider) *Client {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+	c.tokens = nil
+	if p != nil {
+		c.tokens = &tokenSource{provide: p}
+	}
+	return c
+}
+
+func (c *Client) tokenSource() *tokenSource {
+	c.authMu.RLock()
+	defer c.authMu.RUnlock()
+	return c.tokens
+}
+
+// sendAuthorized sends req with the token of the token provider, if any,
+// refreshing the token and retrying once if it is rejected.
+func (c *Client) sendAuthorized(ctx context.Context, req *http.Request) (*http.Response, error) {
+	ts := c.tokenSource()
+	if ts == nil {
+		return c.send(ctx, req)
+	}
+
+	token, err := ts.get(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.send(ctx, withToken(ctx, req, token))
+	if err != nil || res.StatusCode != http.StatusUnauthorized || !replayable(req) {
+		return res, err
+	}
+
+	io.Copy(io.Discard, res.Body)
+	res.Body.Close()
+	token, err = ts.get(ctx, &token)
+	if err != nil {
+		return nil, err
+	}
+	return c.send(ctx, withToken(ctx, req, token))
+}
+
+// withToken returns a copy of req authorized with token, leaving the caller's headers untouched.
+func withToken(ctx context.Context, req *http.Request, token string) *http.Request {
+	r := req.Clone(ctx)
+	PATAuth(token).Apply(r)
+	return r
+}
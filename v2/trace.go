@@ -0,0 +1,50 @@
+package labrinth
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Tracer is called before each Do with a low-cardinality operation name
+// such as "GET /project/{id}". The returned context is used for the request,
+// so span and propagation data can be attached to it.
+// The returned func is called with the result once Do finishes.
+type Tracer func(ctx context.Context, operation string) (context.Context, func(err error))
+
+func (c *Client) SetTracer(t Tracer) *Client {
+	c.tracer = t
+	return c
+}
+
+// Path segments that are part of the API routes.
+// Any other segment is treated as an identifier.
+var staticPathSegments = []string{
+	"v3",
+	"search",
+	"project", "projects", "projects_random",
+	"check", "icon", "gallery", "dependencies", "follow", "schedule", "members",
+	"version", "versions", "version_file", "version_files", "update", "download",
+	"team", "teams", "join", "owner",
+	"user", "users", "payouts", "notifications", "notification", "follows",
+	"thread", "threads", "message", "inbox",
+	"report",
+	"tag", "category", "loader", "game_version", "license", "donation_platform",
+	"report_type", "project_type", "side_type",
+	"organization", "organizations",
+	"statistics",
+	"updates", "forge_updates.json",
+}
+
+// operationName returns the method and route template of req, e.g. "GET /project/{id}".
+func (c *Client) operationName(req *http.Request) string {
+	p := strings.TrimPrefix(req.URL.Path, strings.TrimSuffix(c.BaseURL.Path, "/"))
+	segments := strings.Split(strings.Trim(p, "/"), "/")
+	for i, s := range segments {
+		if s != "" && !slices.Contains(staticPathSegments, s) {
+			segments[i] = "{id}"
+		}
+	}
+	return req.Method + " /" + strings.Join(segments, "/")
+}
@@ -0,0 +1,24 @@
+package labrinth
+
+import (
+	"context"
+	"net/http"
+)
+
+type UsersService service
+
+// GetCurrent returns the authorized user.
+func (s *UsersService) GetCurrent(ctx context.Context) (*User, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "user", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user = new(User)
+	res, err := s.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return user, res, nil
+}
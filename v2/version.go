@@ -0,0 +1,24 @@
+package labrinth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type VersionsService service
+
+func (s *VersionsService) Get(ctx context.Context, id string) (*Version, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("version/%s", id), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var version = new(Version)
+	res, err := s.client.Do(ctx, req, version)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return version, res, nil
+}
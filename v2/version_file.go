@@ -0,0 +1,68 @@
+package labrinth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	neturl "net/url"
+
+	"github.com/samber/lo"
+)
+
+type VersionFilesService service
+
+// GetFromHash returns the version containing the file with hash,
+// e.g. one computed by ComputeHash.
+func (s *VersionFilesService) GetFromHash(ctx context.Context, hash string, algorithm HashAlgorithm) (*Version, *Response, error) {
+	if !algorithm.IsValid() {
+		return nil, nil, fmt.Errorf("unknown hash algorithm %q", algorithm)
+	}
+	q := neturl.Values{}
+	q.Add("algorithm", string(algorithm))
+
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("version_file/%s?%s", hash, q.Encode()), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var version = new(Version)
+	res, err := s.client.Do(ctx, req, version)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return version, res, nil
+}
+
+type versionFilesParams struct {
+	Hashes    []string      `json:"hashes"`
+	Algorithm HashAlgorithm `json:"algorithm"`
+}
+
+// GetFromHashes returns the versions containing files with the given hashes, keyed by hash.
+// Hashes not found on Modrinth are absent from the map.
+func (s *VersionFilesService) GetFromHashes(ctx context.Context, hashes []string, algorithm HashAlgorithm) (map[string]*Version, *Response, error) {
+	if !algorithm.IsValid() {
+		return nil, nil, fmt.Errorf("unknown hash algorithm %q", algorithm)
+	}
+	hashes = lo.Uniq(hashes)
+	if len(hashes) == 0 {
+		return map[string]*Version{}, nil, nil
+	}
+
+	req, err := s.client.NewRequest(http.MethodPost, "version_files", &versionFilesParams{
+		Hashes:    hashes,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var versions = map[string]*Version{}
+	res, err := s.client.Do(ctx, req, &versions)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return versions, res, nil
+}
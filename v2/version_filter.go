@@ -0,0 +1,60 @@
+package labrinth
+
+import (
+	"slices"
+
+	"github.com/samber/lo"
+)
+
+// FilterVersionsOptions selects versions in FilterVersions.
+// Each non-empty field matches versions having any of its values.
+type FilterVersionsOptions struct {
+	Loaders      []string
+	GameVersions []string
+	VersionTypes []VersionType
+}
+
+func (o *FilterVersionsOptions) match(v *Version) bool {
+	if len(o.Loaders) != 0 && !lo.Some(v.Loaders, o.Loaders) {
+		return false
+	}
+	if len(o.GameVersions) != 0 && !lo.Some(v.GameVersions, o.GameVersions) {
+		return false
+	}
+	if len(o.VersionTypes) != 0 && !slices.Contains(o.VersionTypes, v.VersionType) {
+		return false
+	}
+	return true
+}
+
+// FilterVersions returns the versions matching opts, in their original order.
+// A nil opts matches all versions.
+func FilterVersions(versions []*Version, opts *FilterVersionsOptions) []*Version {
+	if opts == nil {
+		opts = &FilterVersionsOptions{}
+	}
+	return lo.Filter(versions, func(v *Version, _ int) bool {
+		return opts.match(v)
+	})
+}
+
+// LatestFor returns the most recently published release supporting both loader and gameVersion,
+// or nil when none does. An empty loader or gameVersion matches any.
+// Of versions published at the same time, the first in versions is returned.
+func LatestFor(versions []*Version, loader, gameVersion string) *Version {
+	opts := &FilterVersionsOptions{VersionTypes: []VersionType{VersionType_Release}}
+	if loader != "" {
+		opts.Loaders = []string{loader}
+	}
+	if gameVersion != "" {
+		opts.GameVersions = []string{gameVersion}
+	}
+
+	var latest *Version
+	for _, v := range FilterVersions(versions, opts) {
+		if latest == nil || v.DatePublished.After(latest.DatePublished.Time) {
+			latest = v
+		}
+	}
+	return latest
+}
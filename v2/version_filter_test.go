@@ -0,0 +1,87 @@
+package labrinth
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func testVersion(id string, typ VersionType, published time.Time, loaders, gameVersions []string) *Version {
+	return &Version{
+		ID:            id,
+		VersionType:   typ,
+		DatePublished: Time{published},
+		Loaders:       loaders,
+		GameVersions:  gameVersions,
+	}
+}
+
+func TestLatestFor(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC) }
+	fabric, forge := []string{"fabric"}, []string{"forge"}
+	versions := []*Version{
+		testVersion("old", VersionType_Release, day(1), fabric, []string{"1.20.1"}),
+		testVersion("beta", VersionType_Beta, day(9), fabric, []string{"1.20.1"}),
+		testVersion("tie1", VersionType_Release, day(5), fabric, []string{"1.20.1", "1.20.2"}),
+		testVersion("tie2", VersionType_Release, day(5), fabric, []string{"1.20.1"}),
+		testVersion("forge", VersionType_Release, day(7), forge, []string{"1.20.1"}),
+		testVersion("new", VersionType_Release, day(8), fabric, []string{"1.21"}),
+	}
+
+	tests := []struct {
+		loader, gameVersion string
+		want                string
+	}{
+		{"fabric", "1.20.1", "tie1"},
+		{"fabric", "1.20.2", "tie1"},
+		{"forge", "1.20.1", "forge"},
+		{"fabric", "", "new"},
+		{"", "1.20.1", "forge"},
+		{"", "", "new"},
+		{"quilt", "1.20.1", ""},
+		{"fabric", "1.19", ""},
+	}
+	for _, tt := range tests {
+		var got string
+		if v := LatestFor(versions, tt.loader, tt.gameVersion); v != nil {
+			got = v.ID
+		}
+		if got != tt.want {
+			t.Errorf("LatestFor(%q, %q) = %q, want %q", tt.loader, tt.gameVersion, got, tt.want)
+		}
+	}
+
+	if v := LatestFor(nil, "fabric", "1.20.1"); v != nil {
+		t.Errorf("LatestFor(nil) = %v", v)
+	}
+}
+
+func TestFilterVersions(t *testing.T) {
+	now := time.Now()
+	versions := []*Version{
+		testVersion("a", VersionType_Release, now, []string{"fabric", "quilt"}, []string{"1.20.1"}),
+		testVersion("b", VersionType_Beta, now, []string{"forge"}, []string{"1.20.1"}),
+		testVersion("c", VersionType_Alpha, now, []string{"quilt"}, []string{"1.21"}),
+	}
+	tests := []struct {
+		name string
+		opts *FilterVersionsOptions
+		want []string
+	}{
+		{"nil", nil, []string{"a", "b", "c"}},
+		{"loader", &FilterVersionsOptions{Loaders: []string{"quilt"}}, []string{"a", "c"}},
+		{"game version", &FilterVersionsOptions{GameVersions: []string{"1.20.1"}}, []string{"a", "b"}},
+		{"types", &FilterVersionsOptions{VersionTypes: []VersionType{VersionType_Beta, VersionType_Alpha}}, []string{"b", "c"}},
+		{"all", &FilterVersionsOptions{Loaders: []string{"quilt"}, GameVersions: []string{"1.21"}, VersionTypes: []VersionType{VersionType_Alpha}}, []string{"c"}},
+		{"none", &FilterVersionsOptions{Loaders: []string{"neoforge"}}, nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, v := range FilterVersions(versions, tt.opts) {
+			got = append(got, v.ID)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: FilterVersions = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}